@@ -0,0 +1,9 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ccr_stats" "stats" {}
+
+output "failed_follow_indices" {
+  value = data.elasticstack_elasticsearch_ccr_stats.stats.auto_follow_stats[0].number_of_failed_follow_indices
+}
@@ -0,0 +1,20 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "orders" {
+  name = "orders"
+}
+
+// wait for the primary and replica shards of the index to be assigned
+data "elasticstack_elasticsearch_cluster_health" "orders" {
+  indices = [elasticstack_elasticsearch_index.orders.name]
+
+  wait_for_status        = "green"
+  wait_for_active_shards = "all"
+  timeout                = "5m"
+}
+
+output "cluster_status" {
+  value = data.elasticstack_elasticsearch_cluster_health.orders.status
+}
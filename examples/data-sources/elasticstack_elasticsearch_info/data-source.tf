@@ -0,0 +1,9 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_info" "cluster" {}
+
+output "cluster_version" {
+  value = data.elasticstack_elasticsearch_info.cluster.version
+}
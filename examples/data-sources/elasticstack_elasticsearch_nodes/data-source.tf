@@ -0,0 +1,16 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+// only the data nodes of the cluster
+data "elasticstack_elasticsearch_nodes" "data" {
+  node_ids = ["data:true"]
+}
+
+output "data_node_names" {
+  value = data.elasticstack_elasticsearch_nodes.data.nodes[*].name
+}
+
+output "installed_plugins" {
+  value = distinct(flatten(data.elasticstack_elasticsearch_nodes.data.nodes[*].plugins[*].name))
+}
@@ -0,0 +1,15 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_painless_execute" "discount" {
+  source = "params.price * (1 - params.discount)"
+  params = jsonencode({
+    price    = 100
+    discount = 0.2
+  })
+}
+
+output "discounted_price" {
+  value = data.elasticstack_elasticsearch_painless_execute.discount.result
+}
@@ -0,0 +1,15 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_api_keys" "expiring" {
+  realm          = "native1"
+  expires_within = "168h"
+  metadata = {
+    env = "production"
+  }
+}
+
+output "expiring_api_keys" {
+  value = [for key in data.elasticstack_elasticsearch_security_api_keys.expiring.api_keys : "${key.name} (${key.owner})"]
+}
@@ -0,0 +1,12 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_application_privilege" "read" {
+  application = "myapp"
+  name        = "read"
+}
+
+output "read_actions" {
+  value = data.elasticstack_elasticsearch_security_application_privilege.read.actions
+}
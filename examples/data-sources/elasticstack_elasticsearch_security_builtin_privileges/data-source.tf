@@ -0,0 +1,15 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_builtin_privileges" "builtin" {}
+
+resource "elasticstack_elasticsearch_security_role" "monitoring" {
+  name    = "monitoring"
+  cluster = [for p in data.elasticstack_elasticsearch_security_builtin_privileges.builtin.cluster : p if startswith(p, "monitor")]
+
+  indices {
+    names      = [".monitoring-*"]
+    privileges = ["read"]
+  }
+}
@@ -0,0 +1,32 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+// fail at plan when the provider lacks the privileges to manage the lifecycle of the logs
+data "elasticstack_elasticsearch_security_has_privileges" "ilm" {
+  cluster = ["manage_ilm", "manage_index_templates"]
+
+  index {
+    names      = ["logs-*"]
+    privileges = ["manage", "create_doc"]
+  }
+
+  lifecycle {
+    postcondition {
+      condition     = self.has_all_requested
+      error_message = "missing ${join(", ", self.missing_privileges)}"
+    }
+  }
+}
+
+// check the privileges of another user
+data "elasticstack_elasticsearch_security_has_privileges" "ingest" {
+  run_as  = "ingest_user"
+  cluster = ["monitor"]
+
+  application {
+    application = "kibana-.kibana"
+    privileges  = ["feature_discover.read"]
+    resources   = ["*"]
+  }
+}
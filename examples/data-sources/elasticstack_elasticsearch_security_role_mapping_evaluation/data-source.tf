@@ -0,0 +1,29 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_evaluation" "jdoe" {
+  name     = "ldap_admins"
+  username = "jdoe"
+  dn       = "cn=John Doe,ou=People,dc=example,dc=com"
+  groups   = ["cn=admins,dc=example,dc=com"]
+  realm    = "ldap1"
+}
+
+output "jdoe_roles" {
+  value = data.elasticstack_elasticsearch_security_role_mapping_evaluation.jdoe.granted_roles
+}
+
+# the rules can also be evaluated without a cluster, e.g. before they are applied
+data "elasticstack_elasticsearch_security_role_mapping_evaluation" "offline" {
+  rules = jsonencode({
+    all = [
+      { field = { "realm.name" = "saml1" } },
+      { field = { groups = "engineering" } },
+    ]
+  })
+  roles    = ["viewer"]
+  username = "jdoe"
+  realm    = "saml1"
+  groups   = ["engineering"]
+}
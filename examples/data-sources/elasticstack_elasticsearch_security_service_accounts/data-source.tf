@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_service_accounts" "elastic" {
+  namespace = "elastic"
+}
+
+output "service_account_credentials" {
+  value = { for account in data.elasticstack_elasticsearch_security_service_accounts.elastic.service_accounts : account.principal => account.credentials_count }
+}
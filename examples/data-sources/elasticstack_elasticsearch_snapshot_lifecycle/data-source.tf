@@ -0,0 +1,15 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_snapshot_lifecycle" "nightly" {
+  name = "nightly-snapshots"
+}
+
+output "last_successful_snapshot" {
+  value = one(data.elasticstack_elasticsearch_snapshot_lifecycle.nightly.last_success[*].snapshot_name)
+}
+
+output "snapshots_failed" {
+  value = data.elasticstack_elasticsearch_snapshot_lifecycle.nightly.stats[0].snapshots_failed
+}
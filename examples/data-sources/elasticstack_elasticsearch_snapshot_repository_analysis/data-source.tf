@@ -0,0 +1,16 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_snapshot_repository_analysis" "backups" {
+  name = "my_s3_repo"
+
+  blob_count          = 200
+  max_blob_size       = "50mb"
+  max_total_data_size = "2gb"
+  timeout             = "10m"
+}
+
+output "issues_detected" {
+  value = data.elasticstack_elasticsearch_snapshot_repository_analysis.backups.issues_detected
+}
@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_snapshot_repository_verification" "backups" {
+  name = "my_s3_repo"
+}
+
+output "nodes_with_access" {
+  value = data.elasticstack_elasticsearch_snapshot_repository_verification.backups.nodes[*].name
+}
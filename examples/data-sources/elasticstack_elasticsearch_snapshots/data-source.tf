@@ -0,0 +1,18 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_snapshots" "latest" {
+  repository = "production_backups"
+  names      = ["nightly-*"]
+  state      = "SUCCESS"
+
+  sort  = "start_time"
+  order = "desc"
+  size  = 1
+}
+
+resource "elasticstack_elasticsearch_snapshot_restore" "staging_seed" {
+  repository = data.elasticstack_elasticsearch_snapshots.latest.repository
+  snapshot   = data.elasticstack_elasticsearch_snapshots.latest.snapshots[0].name
+}
@@ -0,0 +1,19 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_remote_cluster" "leader" {
+  name  = "leader"
+  seeds = ["10.0.1.1:9300"]
+}
+
+resource "elasticstack_elasticsearch_ccr_auto_follow_pattern" "logs" {
+  name                            = "logs"
+  remote_cluster                  = elasticstack_elasticsearch_remote_cluster.leader.name
+  leader_index_patterns           = ["logs-*"]
+  leader_index_exclusion_patterns = ["logs-debug-*"]
+  follow_index_pattern            = "{{leader_index}}-copy"
+
+  max_read_request_operation_count = 1024
+  max_outstanding_read_requests    = 16
+}
@@ -0,0 +1,22 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_remote_cluster" "leader" {
+  name  = "leader"
+  seeds = ["10.0.1.1:9300"]
+}
+
+resource "elasticstack_elasticsearch_ccr_follower_index" "logs" {
+  name           = "logs-follower"
+  remote_cluster = elasticstack_elasticsearch_remote_cluster.leader.name
+  leader_index   = "logs"
+
+  settings = jsonencode({
+    "index.number_of_replicas" = 0
+  })
+
+  max_read_request_operation_count = 1024
+  max_outstanding_read_requests    = 16
+  read_poll_timeout                = "30s"
+}
@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_cluster_settings.my_cluster_settings <cluster_uuid>/cluster-settings
@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_ml_anomaly_detection_job.my_job <cluster_uuid>/<job id>
@@ -0,0 +1,37 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_ml_anomaly_detection_job" "example" {
+  job_id      = "web-logs-response-codes"
+  description = "Unusual response code rates"
+  groups      = ["web-logs"]
+
+  analysis_config {
+    bucket_span = "15m"
+
+    detectors {
+      function             = "count"
+      partition_field_name = "response.keyword"
+      detector_description = "Unusual response code rates"
+    }
+
+    influencers = ["clientip", "response.keyword"]
+  }
+
+  analysis_limits {
+    model_memory_limit = "64mb"
+  }
+
+  data_description {
+    time_field = "@timestamp"
+  }
+
+  model_plot_config {
+    enabled = true
+  }
+
+  results_retention_days = 30
+
+  state = "opened"
+}
@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_ml_datafeed.my_datafeed <cluster_uuid>/<datafeed id>
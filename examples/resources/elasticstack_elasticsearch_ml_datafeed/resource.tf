@@ -0,0 +1,40 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_ml_anomaly_detection_job" "example" {
+  job_id = "web-logs-response-codes"
+
+  analysis_config {
+    bucket_span = "15m"
+
+    detectors {
+      function             = "count"
+      partition_field_name = "response.keyword"
+    }
+  }
+
+  data_description {
+    time_field = "@timestamp"
+  }
+
+  state = "opened"
+}
+
+resource "elasticstack_elasticsearch_ml_datafeed" "example" {
+  datafeed_id = "datafeed-web-logs-response-codes"
+  job_id      = elasticstack_elasticsearch_ml_anomaly_detection_job.example.job_id
+  indices     = ["web-logs-*"]
+
+  query = jsonencode({
+    bool = {
+      must = [
+        { match_all = {} }
+      ]
+    }
+  })
+
+  scroll_size = 1000
+
+  state = "started"
+}
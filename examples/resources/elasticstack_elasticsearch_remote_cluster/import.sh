@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_remote_cluster.my_remote_cluster <cluster_uuid>/<remote cluster alias>
@@ -0,0 +1,20 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_remote_cluster" "sniff" {
+  name             = "cluster_one"
+  seeds            = ["10.0.1.1:9300", "10.0.1.2:9300"]
+  skip_unavailable = true
+}
+
+resource "elasticstack_elasticsearch_remote_cluster" "proxy" {
+  name                     = "cluster_two"
+  mode                     = "proxy"
+  proxy_address            = "remote.example.com:9400"
+  proxy_socket_connections = 18
+  server_name              = "remote.example.com"
+  compress                 = "true"
+
+  wait_for_connection = true
+}
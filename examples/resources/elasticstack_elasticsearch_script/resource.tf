@@ -9,6 +9,21 @@ resource "elasticstack_elasticsearch_script" "my_script" {
   context   = "score"
 }
 
+resource "elasticstack_elasticsearch_script" "my_validated_script" {
+  script_id = "my_validated_script"
+  lang      = "painless"
+  source    = "params['price'] * (1 - params['discount'])"
+
+  // run the script during the plan to catch errors early
+  validation {
+    context = "painless_test"
+    params = jsonencode({
+      price    = 100
+      discount = 0.2
+    })
+  }
+}
+
 resource "elasticstack_elasticsearch_script" "my_search_template" {
   script_id = "my_search_template"
   lang      = "mustache"
@@ -26,6 +26,23 @@ resource "elasticstack_elasticsearch_security_api_key" "api_key" {
   })
 }
 
+# Replace the API key every 30 days, the old key is invalidated once the new one exists
+resource "elasticstack_elasticsearch_security_api_key" "rotated_api_key" {
+  name            = "My rotated API key"
+  rotation_period = "720h"
+}
+
+# Create the API key on behalf of another user, who owns the key
+resource "elasticstack_elasticsearch_security_api_key" "granted_api_key" {
+  name = "My granted API key"
+
+  grant {
+    grant_type = "password"
+    username   = "service-user"
+    password   = "changeme"
+  }
+}
+
 output "api_key" {
   value     = elasticstack_elasticsearch_security_api_key.api_key
   sensitive = true
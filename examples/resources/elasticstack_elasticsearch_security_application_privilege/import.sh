@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_security_application_privilege.my_privilege <cluster_uuid>/<application>:<privilege name>
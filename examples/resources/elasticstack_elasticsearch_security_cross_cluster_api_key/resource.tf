@@ -0,0 +1,31 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_cross_cluster_api_key" "remote_access" {
+  name = "My cross-cluster API key"
+
+  access {
+    search {
+      names = ["logs-*"]
+      query = jsonencode({
+        term = { "event.dataset" = "nginx.access" }
+      })
+    }
+    replication {
+      names = ["archive-*"]
+    }
+  }
+
+  expiration = "30d"
+
+  metadata = jsonencode({
+    remote_cluster = "search-cluster"
+  })
+}
+
+# Add the encoded key as `cluster.remote.<alias>.credentials` to the keystore of the local cluster
+output "cross_cluster_api_key" {
+  value     = elasticstack_elasticsearch_security_cross_cluster_api_key.remote_access.encoded
+  sensitive = true
+}
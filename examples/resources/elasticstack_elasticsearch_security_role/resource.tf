@@ -27,3 +27,19 @@ resource "elasticstack_elasticsearch_security_role" "role" {
 output "role" {
   value = elasticstack_elasticsearch_security_role.role
 }
+
+resource "elasticstack_elasticsearch_security_role" "remote_search" {
+  name        = "remote_logs_reader"
+  description = "Cross-cluster search on the remote logs"
+
+  remote_indices {
+    clusters   = ["remote-*"]
+    names      = ["logs-*"]
+    privileges = ["read", "read_cross_cluster"]
+  }
+
+  remote_cluster {
+    clusters   = ["remote-*"]
+    privileges = ["monitor_enrich"]
+  }
+}
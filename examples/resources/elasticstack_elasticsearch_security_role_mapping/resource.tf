@@ -16,6 +16,35 @@ resource "elasticstack_elasticsearch_security_role_mapping" "example" {
   })
 }
 
+resource "elasticstack_elasticsearch_security_role_mapping" "ldap_admins" {
+  name  = "ldap_admins"
+  roles = ["admin"]
+
+  # every `all` block is one of the rules which must all match
+  rule {
+    all {
+      field {
+        name   = "realm.name"
+        values = ["ldap1"]
+      }
+    }
+    all {
+      field {
+        name   = "groups"
+        values = ["cn=admins,dc=example,dc=com", "cn=ops,dc=example,dc=com"]
+      }
+    }
+    all {
+      except {
+        field {
+          name   = "metadata.contractor"
+          values = ["true"]
+        }
+      }
+    }
+  }
+}
+
 output "role" {
   value = elasticstack_elasticsearch_security_role_mapping.example.name
 }
@@ -14,3 +14,12 @@ resource "elasticstack_elasticsearch_security_system_user" "kibana_system" {
     password  = "changeme"
   }
 }
+
+resource "elasticstack_elasticsearch_security_system_user" "remote_monitoring_user" {
+  username = "remote_monitoring_user"
+
+  generate_password {
+    length = 32
+  }
+  rotation_period = "720h"
+}
@@ -36,3 +36,28 @@ resource "elasticstack_elasticsearch_security_user" "dev" {
     "number" = 49
   })
 }
+
+resource "elasticstack_elasticsearch_security_user" "generated" {
+  username = "generateduser"
+  roles    = ["kibana_user"]
+
+  // generate a new password every 30 days, only its hash is kept in the state
+  generate_password {
+    length = 32
+  }
+  rotation_period = "720h"
+}
+
+variable "writeonlyuser_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "elasticstack_elasticsearch_security_user" "write_only" {
+  username = "writeonlyuser"
+  roles    = ["kibana_user"]
+
+  // the password is never stored in the state, bump the version to change it
+  password_wo         = var.writeonlyuser_password
+  password_wo_version = "1"
+}
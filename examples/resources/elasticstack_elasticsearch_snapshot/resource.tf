@@ -0,0 +1,30 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "my_repository" {
+  name = "my_repository"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+resource "elasticstack_elasticsearch_snapshot" "my_snapshot" {
+  repository = elasticstack_elasticsearch_snapshot_repository.my_repository.name
+  name       = "my_snapshot"
+
+  indices              = ["my-index-*"]
+  ignore_unavailable   = true
+  include_global_state = false
+
+  metadata = jsonencode({
+    taken_by = "terraform"
+  })
+
+  delete_on_destroy = true
+
+  timeouts {
+    create = "1h"
+  }
+}
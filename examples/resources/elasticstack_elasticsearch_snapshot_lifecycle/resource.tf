@@ -28,4 +28,7 @@ resource "elasticstack_elasticsearch_snapshot_lifecycle" "slm_policy" {
   expire_after = "30d"
   min_count    = 5
   max_count    = 50
+
+  // take a first snapshot right away to validate the repository
+  execute_on_create = true
 }
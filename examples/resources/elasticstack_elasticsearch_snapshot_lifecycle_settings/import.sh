@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_snapshot_lifecycle_settings.slm <cluster_uuid>/slm-settings
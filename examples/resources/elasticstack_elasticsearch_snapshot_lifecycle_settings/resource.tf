@@ -0,0 +1,9 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_lifecycle_settings" "slm" {
+  enabled            = true
+  retention_schedule = "0 30 1 * * ?"
+  retention_duration = "1h"
+}
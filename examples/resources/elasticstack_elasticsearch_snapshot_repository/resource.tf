@@ -19,3 +19,26 @@ resource "elasticstack_elasticsearch_snapshot_repository" "my_fs_repo" {
     max_restore_bytes_per_sec = "10mb"
   }
 }
+
+resource "elasticstack_elasticsearch_snapshot_repository" "my_s3_repo" {
+  name = "my_s3_repo"
+
+  s3 {
+    bucket                    = "my-snapshots"
+    endpoint                  = "minio.example.com:9000"
+    protocol                  = "https"
+    path_style_access         = true
+    max_restore_bytes_per_sec = "100mb"
+  }
+}
+
+// source-only snapshots stored in a shared filesystem repository
+resource "elasticstack_elasticsearch_snapshot_repository" "my_source_repo" {
+  name = "my_source_repo"
+
+  source {
+    fs {
+      location = "/tmp/source-only"
+    }
+  }
+}
@@ -0,0 +1,26 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_restore" "staging_seed" {
+  repository = "production_backups"
+  snapshot   = "nightly-2023.01.15"
+
+  indices              = ["logs-*", "orders"]
+  include_global_state = false
+  include_aliases      = false
+
+  rename_pattern     = "(.+)"
+  rename_replacement = "restored-$1"
+
+  index_settings = jsonencode({
+    "index.number_of_replicas" = 0
+  })
+  ignore_index_settings = ["index.refresh_interval"]
+
+  delete_on_destroy = true
+
+  timeouts {
+    create = "2h"
+  }
+}
@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_transform.my_transform <cluster_uuid>/<transform name>
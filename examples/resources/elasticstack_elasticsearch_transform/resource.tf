@@ -0,0 +1,61 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_transform" "orders_by_customer" {
+  name        = "orders-by-customer"
+  description = "Total spend per customer"
+
+  source {
+    indices = ["orders-*"]
+    query = jsonencode({
+      term = {
+        status = "completed"
+      }
+    })
+  }
+
+  dest {
+    index = "orders-by-customer"
+  }
+
+  pivot = jsonencode({
+    group_by = {
+      customer_id = {
+        terms = {
+          field = "customer_id"
+        }
+      }
+    }
+    aggregations = {
+      total_spend = {
+        sum = {
+          field = "total"
+        }
+      }
+    }
+  })
+
+  frequency = "5m"
+
+  sync {
+    time {
+      field = "@timestamp"
+      delay = "120s"
+    }
+  }
+
+  retention_policy {
+    time {
+      field   = "@timestamp"
+      max_age = "30d"
+    }
+  }
+
+  settings {
+    max_page_search_size = 1000
+  }
+
+  defer_validation = true
+  enabled          = true
+}
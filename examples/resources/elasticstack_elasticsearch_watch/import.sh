@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_watch.my_watch <cluster_uuid>/<watch id>
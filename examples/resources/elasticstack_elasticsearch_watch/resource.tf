@@ -0,0 +1,55 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_watch" "example" {
+  watch_id = "test_watch"
+  active   = true
+
+  trigger = jsonencode({
+    schedule = {
+      cron = "0 0/1 * * * ?"
+    }
+  })
+
+  input = jsonencode({
+    search = {
+      request = {
+        indices = ["logstash*"]
+        body = {
+          query = {
+            bool = {
+              must = {
+                match = {
+                  response = 404
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  })
+
+  condition = jsonencode({
+    compare = {
+      "ctx.payload.hits.total" = {
+        gt = 0
+      }
+    }
+  })
+
+  actions = jsonencode({
+    log_error = {
+      logging = {
+        text = "Found {{ctx.payload.hits.total}} errors in the logs"
+      }
+    }
+  })
+
+  metadata = jsonencode({
+    example_key = "example value"
+  })
+
+  throttle_period = "30s"
+}
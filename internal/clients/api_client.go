@@ -105,6 +105,17 @@ func NewApiClient(d *schema.ResourceData, meta interface{}) (*ApiClient, diag.Di
 	return defaultClient, nil
 }
 
+// NewApiClientFromDiff returns the client to use while planning a resource, e.g. from a CustomizeDiff function.
+func NewApiClientFromDiff(d *schema.ResourceDiff, meta interface{}) (*ApiClient, diag.Diagnostics) {
+	defaultClient := meta.(*ApiClient)
+
+	if _, ok := d.GetOk(esConnectionKey); ok {
+		return newEsApiClient(d, esConnectionKey, defaultClient.version, false)
+	}
+
+	return defaultClient, nil
+}
+
 func ensureTLSClientConfig(config *elasticsearch.Config) *tls.Config {
 	if config.Transport == nil {
 		config.Transport = http.DefaultTransport.(*http.Transport)
@@ -128,7 +139,7 @@ func (a *ApiClient) ID(ctx context.Context, resourceId string) (*CompositeId, di
 	return &CompositeId{*clusterId, resourceId}, diags
 }
 
-func (a *ApiClient) serverInfo(ctx context.Context) (*models.ClusterInfo, diag.Diagnostics) {
+func (a *ApiClient) ServerInfo(ctx context.Context) (*models.ClusterInfo, diag.Diagnostics) {
 	if a.elasticsearchClusterInfo != nil {
 		return a.elasticsearchClusterInfo, nil
 	}
@@ -154,7 +165,7 @@ func (a *ApiClient) serverInfo(ctx context.Context) (*models.ClusterInfo, diag.D
 }
 
 func (a *ApiClient) ServerVersion(ctx context.Context) (*version.Version, diag.Diagnostics) {
-	info, diags := a.serverInfo(ctx)
+	info, diags := a.ServerInfo(ctx)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -169,7 +180,7 @@ func (a *ApiClient) ServerVersion(ctx context.Context) (*version.Version, diag.D
 }
 
 func (a *ApiClient) ClusterID(ctx context.Context) (*string, diag.Diagnostics) {
-	info, diags := a.serverInfo(ctx)
+	info, diags := a.ServerInfo(ctx)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -188,7 +199,12 @@ func (a *ApiClient) ClusterID(ctx context.Context) (*string, diag.Diagnostics) {
 	return nil, diags
 }
 
-func newEsApiClient(d *schema.ResourceData, key string, version string, useEnvAsDefault bool) (*ApiClient, diag.Diagnostics) {
+// connectionConfig is implemented by both schema.ResourceData and schema.ResourceDiff
+type connectionConfig interface {
+	GetOk(key string) (interface{}, bool)
+}
+
+func newEsApiClient(d connectionConfig, key string, version string, useEnvAsDefault bool) (*ApiClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	config := elasticsearch.Config{}
 	config.Header = http.Header{"User-Agent": []string{fmt.Sprintf("elasticstack-terraform-provider/%s", version)}}
@@ -0,0 +1,215 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func FollowIndex(ctx context.Context, apiClient *clients.ApiClient, follower *models.FollowerIndex) diag.Diagnostics {
+	followerBytes, err := json.Marshal(follower)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().CCR.Follow(
+		follower.Name,
+		bytes.NewReader(followerBytes),
+		apiClient.GetESClient().CCR.Follow.WithWaitForActiveShards("1"),
+		apiClient.GetESClient().CCR.Follow.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to create follower index: %s", follower.Name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func GetFollowerIndexInfo(ctx context.Context, apiClient *clients.ApiClient, name string) (*models.FollowerIndexInfo, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().CCR.FollowInfo([]string{name}, apiClient.GetESClient().CCR.FollowInfo.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get follower index info: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+
+	var infoResponse struct {
+		FollowerIndices []models.FollowerIndexInfo `json:"follower_indices"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&infoResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, info := range infoResponse.FollowerIndices {
+		if info.FollowerIndex == name {
+			return &info, nil
+		}
+	}
+	// the index exists, but it's not a follower index
+	return nil, nil
+}
+
+func PauseFollowIndex(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().CCR.PauseFollow(name, apiClient.GetESClient().CCR.PauseFollow.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to pause follower index: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func ResumeFollowIndex(ctx context.Context, apiClient *clients.ApiClient, name string, params *models.FollowParameters) diag.Diagnostics {
+	paramsBytes, err := json.Marshal(params)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().CCR.ResumeFollow(
+		name,
+		apiClient.GetESClient().CCR.ResumeFollow.WithBody(bytes.NewReader(paramsBytes)),
+		apiClient.GetESClient().CCR.ResumeFollow.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to resume follower index: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func UnfollowIndex(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().CCR.Unfollow(name, apiClient.GetESClient().CCR.Unfollow.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to unfollow index: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func PutAutoFollowPattern(ctx context.Context, apiClient *clients.ApiClient, pattern *models.AutoFollowPattern) diag.Diagnostics {
+	patternBytes, err := json.Marshal(pattern)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().CCR.PutAutoFollowPattern(pattern.Name, bytes.NewReader(patternBytes), apiClient.GetESClient().CCR.PutAutoFollowPattern.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to create or update auto-follow pattern: %s", pattern.Name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func GetAutoFollowPattern(ctx context.Context, apiClient *clients.ApiClient, name string) (*models.AutoFollowPattern, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().CCR.GetAutoFollowPattern(
+		apiClient.GetESClient().CCR.GetAutoFollowPattern.WithName(name),
+		apiClient.GetESClient().CCR.GetAutoFollowPattern.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get auto-follow pattern: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+
+	var patternsResponse struct {
+		Patterns []struct {
+			Name    string `json:"name"`
+			Pattern struct {
+				Active bool `json:"active"`
+				models.AutoFollowPattern
+			} `json:"pattern"`
+		} `json:"patterns"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&patternsResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, p := range patternsResponse.Patterns {
+		if p.Name == name {
+			pattern := p.Pattern.AutoFollowPattern
+			pattern.Name = p.Name
+			pattern.Active = p.Pattern.Active
+			return &pattern, nil
+		}
+	}
+	return nil, nil
+}
+
+func DeleteAutoFollowPattern(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().CCR.DeleteAutoFollowPattern(name, apiClient.GetESClient().CCR.DeleteAutoFollowPattern.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to delete auto-follow pattern: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func PauseAutoFollowPattern(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().CCR.PauseAutoFollowPattern(name, apiClient.GetESClient().CCR.PauseAutoFollowPattern.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to pause auto-follow pattern: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func ResumeAutoFollowPattern(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().CCR.ResumeAutoFollowPattern(name, apiClient.GetESClient().CCR.ResumeAutoFollowPattern.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to resume auto-follow pattern: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func GetCcrStats(ctx context.Context, apiClient *clients.ApiClient) (*models.CcrStats, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().CCR.Stats(apiClient.GetESClient().CCR.Stats.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get cross-cluster replication stats."); diags.HasError() {
+		return nil, diags
+	}
+
+	var stats models.CcrStats
+	if err := json.NewDecoder(res.Body).Decode(&stats); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &stats, nil
+}
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/elastic/go-elasticsearch/v7/esapi"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
@@ -78,6 +80,245 @@ func DeleteSnapshotRepository(ctx context.Context, apiClient *clients.ApiClient,
 	return diags
 }
 
+func VerifySnapshotRepository(ctx context.Context, apiClient *clients.ApiClient, name string) (map[string]string, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().Snapshot.VerifyRepository(name, apiClient.GetESClient().Snapshot.VerifyRepository.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to verify snapshot repository: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+
+	var verifyResponse struct {
+		Nodes map[string]struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&verifyResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	nodes := make(map[string]string, len(verifyResponse.Nodes))
+	for id, n := range verifyResponse.Nodes {
+		nodes[id] = n.Name
+	}
+	return nodes, nil
+}
+
+func CleanupSnapshotRepository(ctx context.Context, apiClient *clients.ApiClient, name string) (*models.SnapshotRepositoryCleanup, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().Snapshot.CleanupRepository(name, apiClient.GetESClient().Snapshot.CleanupRepository.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to clean up snapshot repository: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+
+	var cleanupResponse struct {
+		Results models.SnapshotRepositoryCleanup `json:"results"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&cleanupResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &cleanupResponse.Results, nil
+}
+
+func AnalyzeSnapshotRepository(ctx context.Context, apiClient *clients.ApiClient, name string, params *models.SnapshotRepositoryAnalyzeParams) (*models.SnapshotRepositoryAnalysis, diag.Diagnostics) {
+	opts := []func(*esapi.SnapshotRepositoryAnalyzeRequest){
+		apiClient.GetESClient().Snapshot.RepositoryAnalyze.WithContext(ctx),
+	}
+	if params.BlobCount > 0 {
+		opts = append(opts, apiClient.GetESClient().Snapshot.RepositoryAnalyze.WithBlobCount(params.BlobCount))
+	}
+	if params.Concurrency > 0 {
+		opts = append(opts, apiClient.GetESClient().Snapshot.RepositoryAnalyze.WithConcurrency(params.Concurrency))
+	}
+	if params.ReadNodeCount > 0 {
+		opts = append(opts, apiClient.GetESClient().Snapshot.RepositoryAnalyze.WithReadNodeCount(params.ReadNodeCount))
+	}
+	if params.EarlyReadNodeCount > 0 {
+		opts = append(opts, apiClient.GetESClient().Snapshot.RepositoryAnalyze.WithEarlyReadNodeCount(params.EarlyReadNodeCount))
+	}
+	if params.Seed != nil {
+		opts = append(opts, apiClient.GetESClient().Snapshot.RepositoryAnalyze.WithSeed(*params.Seed))
+	}
+	if params.MaxBlobSize != "" {
+		opts = append(opts, apiClient.GetESClient().Snapshot.RepositoryAnalyze.WithMaxBlobSize(params.MaxBlobSize))
+	}
+	if params.MaxTotalDataSize != "" {
+		opts = append(opts, apiClient.GetESClient().Snapshot.RepositoryAnalyze.WithMaxTotalDataSize(params.MaxTotalDataSize))
+	}
+	if params.Timeout > 0 {
+		opts = append(opts, apiClient.GetESClient().Snapshot.RepositoryAnalyze.WithTimeout(params.Timeout))
+	}
+	res, err := apiClient.GetESClient().Snapshot.RepositoryAnalyze(name, opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to analyze snapshot repository: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+
+	var analysis models.SnapshotRepositoryAnalysis
+	if err := json.NewDecoder(res.Body).Decode(&analysis); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &analysis, nil
+}
+
+func CreateSnapshot(ctx context.Context, apiClient *clients.ApiClient, repository, snapshot string, config *models.SnapshotPolicyConfig) diag.Diagnostics {
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().Snapshot.Create(
+		repository,
+		snapshot,
+		apiClient.GetESClient().Snapshot.Create.WithBody(bytes.NewReader(configBytes)),
+		apiClient.GetESClient().Snapshot.Create.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to create snapshot: %s/%s", repository, snapshot)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func GetSnapshot(ctx context.Context, apiClient *clients.ApiClient, repository, snapshot string) (*models.SnapshotInfo, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().Snapshot.Get(repository, []string{snapshot}, apiClient.GetESClient().Snapshot.Get.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get snapshot: %s/%s", repository, snapshot)); diags.HasError() {
+		return nil, diags
+	}
+
+	var snapshotsResponse struct {
+		Snapshots []models.SnapshotInfo `json:"snapshots"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&snapshotsResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, s := range snapshotsResponse.Snapshots {
+		if s.Snapshot == snapshot {
+			return &s, nil
+		}
+	}
+	return nil, nil
+}
+
+func GetSnapshots(ctx context.Context, apiClient *clients.ApiClient, repository string, names []string, params *models.GetSnapshotsParams) ([]models.SnapshotInfo, diag.Diagnostics) {
+	opts := []func(*esapi.SnapshotGetRequest){
+		apiClient.GetESClient().Snapshot.Get.WithContext(ctx),
+	}
+	if params.Sort != "" {
+		opts = append(opts, apiClient.GetESClient().Snapshot.Get.WithSort(params.Sort))
+	}
+	if params.Order != "" {
+		opts = append(opts, apiClient.GetESClient().Snapshot.Get.WithOrder(params.Order))
+	}
+	if params.Size > 0 {
+		opts = append(opts, apiClient.GetESClient().Snapshot.Get.WithSize(params.Size))
+	}
+	res, err := apiClient.GetESClient().Snapshot.Get(repository, names, opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get snapshots from repository: %s", repository)); diags.HasError() {
+		return nil, diags
+	}
+
+	var snapshotsResponse struct {
+		Snapshots []models.SnapshotInfo `json:"snapshots"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&snapshotsResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return snapshotsResponse.Snapshots, nil
+}
+
+func GetSnapshotStatus(ctx context.Context, apiClient *clients.ApiClient, repository, snapshot string) (*models.SnapshotStatus, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().Snapshot.Status(
+		apiClient.GetESClient().Snapshot.Status.WithRepository(repository),
+		apiClient.GetESClient().Snapshot.Status.WithSnapshot(snapshot),
+		apiClient.GetESClient().Snapshot.Status.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get snapshot status: %s/%s", repository, snapshot)); diags.HasError() {
+		return nil, diags
+	}
+
+	var statusResponse struct {
+		Snapshots []models.SnapshotStatus `json:"snapshots"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&statusResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, s := range statusResponse.Snapshots {
+		if s.Snapshot == snapshot {
+			return &s, nil
+		}
+	}
+	return nil, nil
+}
+
+func DeleteSnapshot(ctx context.Context, apiClient *clients.ApiClient, repository, snapshot string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().Snapshot.Delete(repository, snapshot, apiClient.GetESClient().Snapshot.Delete.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to delete snapshot: %s/%s", repository, snapshot)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func RestoreSnapshot(ctx context.Context, apiClient *clients.ApiClient, repository, snapshot string, restore *models.SnapshotRestore) (*models.SnapshotRestoreInfo, diag.Diagnostics) {
+	restoreBytes, err := json.Marshal(restore)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().Snapshot.Restore(
+		repository,
+		snapshot,
+		apiClient.GetESClient().Snapshot.Restore.WithBody(bytes.NewReader(restoreBytes)),
+		apiClient.GetESClient().Snapshot.Restore.WithWaitForCompletion(true),
+		apiClient.GetESClient().Snapshot.Restore.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to restore snapshot: %s/%s", repository, snapshot)); diags.HasError() {
+		return nil, diags
+	}
+
+	var restoreResponse struct {
+		Snapshot models.SnapshotRestoreInfo `json:"snapshot"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&restoreResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &restoreResponse.Snapshot, nil
+}
+
 func PutSlm(ctx context.Context, apiClient *clients.ApiClient, slm *models.SnapshotPolicy) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -130,6 +371,32 @@ func GetSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) (
 	return nil, diags
 }
 
+func GetSlmInfo(ctx context.Context, apiClient *clients.ApiClient, slmName string) (*models.SnapshotPolicyInfo, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().SlmGetLifecycle(
+		apiClient.GetESClient().SlmGetLifecycle.WithPolicyID(slmName),
+		apiClient.GetESClient().SlmGetLifecycle.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get SLM policy: %s", slmName)); diags.HasError() {
+		return nil, diags
+	}
+
+	var slmResponse map[string]models.SnapshotPolicyInfo
+	if err := json.NewDecoder(res.Body).Decode(&slmResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if slm, ok := slmResponse[slmName]; ok {
+		return &slm, nil
+	}
+	return nil, nil
+}
+
 func DeleteSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	res, err := apiClient.GetESClient().SlmDeleteLifecycle(slmName, apiClient.GetESClient().SlmDeleteLifecycle.WithContext(ctx))
@@ -144,6 +411,68 @@ func DeleteSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string
 	return diags
 }
 
+func ExecuteSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) (string, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().SlmExecuteLifecycle(slmName, apiClient.GetESClient().SlmExecuteLifecycle.WithContext(ctx))
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to execute SLM policy: %s", slmName)); diags.HasError() {
+		return "", diags
+	}
+
+	var executeResponse struct {
+		SnapshotName string `json:"snapshot_name"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&executeResponse); err != nil {
+		return "", diag.FromErr(err)
+	}
+	return executeResponse.SnapshotName, nil
+}
+
+func GetSlmStatus(ctx context.Context, apiClient *clients.ApiClient) (string, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().SlmGetStatus(apiClient.GetESClient().SlmGetStatus.WithContext(ctx))
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get SLM status"); diags.HasError() {
+		return "", diags
+	}
+
+	var statusResponse struct {
+		OperationMode string `json:"operation_mode"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&statusResponse); err != nil {
+		return "", diag.FromErr(err)
+	}
+	return statusResponse.OperationMode, nil
+}
+
+func StartSlm(ctx context.Context, apiClient *clients.ApiClient) diag.Diagnostics {
+	res, err := apiClient.GetESClient().SlmStart(apiClient.GetESClient().SlmStart.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to start SLM"); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func StopSlm(ctx context.Context, apiClient *clients.ApiClient) diag.Diagnostics {
+	res, err := apiClient.GetESClient().SlmStop(apiClient.GetESClient().SlmStop.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to stop SLM"); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
 func PutSettings(ctx context.Context, apiClient *clients.ApiClient, settings map[string]interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	settingsBytes, err := json.Marshal(settings)
@@ -180,6 +509,28 @@ func GetSettings(ctx context.Context, apiClient *clients.ApiClient) (map[string]
 	return clusterSettings, diags
 }
 
+// GetSettingsWithDefaults returns the cluster settings along with the default value of every known setting
+func GetSettingsWithDefaults(ctx context.Context, apiClient *clients.ApiClient) (map[string]interface{}, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().Cluster.GetSettings(
+		apiClient.GetESClient().Cluster.GetSettings.WithFlatSettings(true),
+		apiClient.GetESClient().Cluster.GetSettings.WithIncludeDefaults(true),
+		apiClient.GetESClient().Cluster.GetSettings.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to read cluster settings."); diags.HasError() {
+		return nil, diags
+	}
+
+	clusterSettings := make(map[string]interface{})
+	if err := json.NewDecoder(res.Body).Decode(&clusterSettings); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return clusterSettings, nil
+}
+
 func GetScript(ctx context.Context, apiClient *clients.ApiClient, id string) (*models.Script, diag.Diagnostics) {
 	res, err := apiClient.GetESClient().GetScript(id, apiClient.GetESClient().GetScript.WithContext(ctx))
 	if err != nil {
@@ -223,6 +574,81 @@ func PutScript(ctx context.Context, apiClient *clients.ApiClient, script *models
 	return nil
 }
 
+func ExecutePainlessScript(ctx context.Context, apiClient *clients.ApiClient, execute *models.PainlessExecute) (*models.PainlessExecuteResult, diag.Diagnostics) {
+	executeBytes, err := json.Marshal(execute)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().ScriptsPainlessExecute(
+		apiClient.GetESClient().ScriptsPainlessExecute.WithBody(bytes.NewReader(executeBytes)),
+		apiClient.GetESClient().ScriptsPainlessExecute.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusBadRequest {
+		var body struct {
+			Error *models.ScriptError `json:"error"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		return nil, scriptErrorDiags("Unable to execute the painless script", execute.Script.Source, body.Error)
+	}
+	if diags := utils.CheckError(res, "Unable to execute the painless script"); diags.HasError() {
+		return nil, diags
+	}
+
+	var result models.PainlessExecuteResult
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &result, nil
+}
+
+// scriptErrorDiags reports a script exception along with the line and column of the error in the script source
+func scriptErrorDiags(summary, source string, scriptErr *models.ScriptError) diag.Diagnostics {
+	if scriptErr == nil {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: summary}}
+	}
+
+	reason := scriptErr.Reason
+	if scriptErr.CausedBy != nil && scriptErr.CausedBy.Reason != "" {
+		reason = fmt.Sprintf("%s: %s", scriptErr.Reason, scriptErr.CausedBy.Reason)
+	}
+	detail := reason
+	if scriptErr.Position != nil && source != "" {
+		line, column := scriptPosition(source, scriptErr.Position.Offset)
+		detail = fmt.Sprintf("Line %d, column %d: %s", line, column, reason)
+	}
+	if len(scriptErr.ScriptStack) > 0 {
+		detail = fmt.Sprintf("%s\n\n%s", detail, strings.Join(scriptErr.ScriptStack, "\n"))
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   detail,
+	}}
+}
+
+// scriptPosition converts the offset of a character in the script into its line and column, both starting at 1
+func scriptPosition(source string, offset int) (int, int) {
+	line, column := 1, 1
+	for i, r := range []rune(source) {
+		if i >= offset {
+			break
+		}
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return line, column
+}
+
 func DeleteScript(ctx context.Context, apiClient *clients.ApiClient, id string) diag.Diagnostics {
 	res, err := apiClient.GetESClient().DeleteScript(id, apiClient.GetESClient().DeleteScript.WithContext(ctx))
 	if err != nil {
@@ -234,3 +660,89 @@ func DeleteScript(ctx context.Context, apiClient *clients.ApiClient, id string)
 	}
 	return nil
 }
+
+func GetRemoteInfo(ctx context.Context, apiClient *clients.ApiClient) (map[string]models.RemoteClusterInfo, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().Cluster.RemoteInfo(apiClient.GetESClient().Cluster.RemoteInfo.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get remote cluster info."); diags.HasError() {
+		return nil, diags
+	}
+
+	remoteInfo := make(map[string]models.RemoteClusterInfo)
+	if err := json.NewDecoder(res.Body).Decode(&remoteInfo); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return remoteInfo, nil
+}
+
+func GetClusterHealth(ctx context.Context, apiClient *clients.ApiClient, params *models.ClusterHealthParams) (*models.ClusterHealth, diag.Diagnostics) {
+	opts := []func(*esapi.ClusterHealthRequest){
+		apiClient.GetESClient().Cluster.Health.WithContext(ctx),
+	}
+	if len(params.Indices) > 0 {
+		opts = append(opts, apiClient.GetESClient().Cluster.Health.WithIndex(params.Indices...))
+	}
+	if params.WaitForStatus != "" {
+		opts = append(opts, apiClient.GetESClient().Cluster.Health.WithWaitForStatus(params.WaitForStatus))
+	}
+	if params.WaitForNodes != "" {
+		opts = append(opts, apiClient.GetESClient().Cluster.Health.WithWaitForNodes(params.WaitForNodes))
+	}
+	if params.WaitForActiveShards != "" {
+		opts = append(opts, apiClient.GetESClient().Cluster.Health.WithWaitForActiveShards(params.WaitForActiveShards))
+	}
+	if params.WaitForNoRelocatingShards {
+		opts = append(opts, apiClient.GetESClient().Cluster.Health.WithWaitForNoRelocatingShards(true))
+	}
+	if params.WaitForNoInitializingShards {
+		opts = append(opts, apiClient.GetESClient().Cluster.Health.WithWaitForNoInitializingShards(true))
+	}
+	if params.Timeout > 0 {
+		opts = append(opts, apiClient.GetESClient().Cluster.Health.WithTimeout(params.Timeout))
+	}
+	res, err := apiClient.GetESClient().Cluster.Health(opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	// the cluster responds with 408 and the current health when the wait conditions are not met in time
+	if res.StatusCode != http.StatusRequestTimeout {
+		if diags := utils.CheckError(res, "Unable to get cluster health"); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	var health models.ClusterHealth
+	if err := json.NewDecoder(res.Body).Decode(&health); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &health, nil
+}
+
+func GetNodesInfo(ctx context.Context, apiClient *clients.ApiClient, nodeIds []string) (*models.NodesInfo, diag.Diagnostics) {
+	opts := []func(*esapi.NodesInfoRequest){
+		apiClient.GetESClient().Nodes.Info.WithContext(ctx),
+		// the basic node information is always returned, only ask for the plugins and modules on top of it
+		apiClient.GetESClient().Nodes.Info.WithMetric("plugins"),
+	}
+	if len(nodeIds) > 0 {
+		opts = append(opts, apiClient.GetESClient().Nodes.Info.WithNodeID(nodeIds...))
+	}
+	res, err := apiClient.GetESClient().Nodes.Info(opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get nodes info"); diags.HasError() {
+		return nil, diags
+	}
+
+	var nodes models.NodesInfo
+	if err := json.NewDecoder(res.Body).Decode(&nodes); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &nodes, nil
+}
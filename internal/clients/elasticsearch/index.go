@@ -255,6 +255,30 @@ func DeleteIndex(ctx context.Context, apiClient *clients.ApiClient, name string)
 	return diags
 }
 
+func CloseIndex(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().Indices.Close([]string{name}, apiClient.GetESClient().Indices.Close.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to close the index: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func OpenIndex(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().Indices.Open([]string{name}, apiClient.GetESClient().Indices.Open.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to open the index: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
 func GetIndex(ctx context.Context, apiClient *clients.ApiClient, name string) (*models.Index, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -442,6 +466,62 @@ func GetIngestPipeline(ctx context.Context, apiClient *clients.ApiClient, name *
 	return &pipeline, diags
 }
 
+// SimulateScriptProcessor runs the script processor against the given documents, reporting the errors of the script
+func SimulateScriptProcessor(ctx context.Context, apiClient *clients.ApiClient, processor *models.ProcessorScript, docs []map[string]interface{}) diag.Diagnostics {
+	simulatedDocs := make([]map[string]interface{}, len(docs))
+	for i, doc := range docs {
+		simulatedDocs[i] = map[string]interface{}{"_source": doc}
+	}
+	simulate := map[string]interface{}{
+		"pipeline": map[string]interface{}{
+			"processors": []interface{}{
+				map[string]*models.ProcessorScript{"script": processor},
+			},
+		},
+		"docs": simulatedDocs,
+	}
+	simulateBytes, err := json.Marshal(simulate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().Ingest.Simulate(bytes.NewReader(simulateBytes), apiClient.GetESClient().Ingest.Simulate.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+
+	summary := "Unable to run the script processor"
+	// inline scripts are compiled along with the pipeline, which fails the whole request
+	if res.StatusCode == http.StatusBadRequest {
+		var body struct {
+			Error *models.ScriptError `json:"error"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+			return diag.FromErr(err)
+		}
+		return scriptErrorDiags(summary, processor.Source, body.Error)
+	}
+	if diags := utils.CheckError(res, summary); diags.HasError() {
+		return diags
+	}
+
+	var result struct {
+		Docs []struct {
+			Error *models.ScriptError `json:"error"`
+		} `json:"docs"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return diag.FromErr(err)
+	}
+	var diags diag.Diagnostics
+	for i, doc := range result.Docs {
+		if doc.Error != nil {
+			diags = append(diags, scriptErrorDiags(fmt.Sprintf("%s on document %d", summary, i), processor.Source, doc.Error)...)
+		}
+	}
+	return diags
+}
+
 func DeleteIngestPipeline(ctx context.Context, apiClient *clients.ApiClient, name *string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -0,0 +1,331 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func PutAnomalyDetectionJob(ctx context.Context, apiClient *clients.ApiClient, job *models.AnomalyDetectionJob) diag.Diagnostics {
+	jobBytes, err := json.Marshal(job)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().ML.PutJob(job.JobID, bytes.NewReader(jobBytes), apiClient.GetESClient().ML.PutJob.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to create anomaly detection job: %s", job.JobID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func UpdateAnomalyDetectionJob(ctx context.Context, apiClient *clients.ApiClient, job *models.AnomalyDetectionJob) diag.Diagnostics {
+	// the analysis and data description can only be set when creating the job
+	update := models.AnomalyDetectionJobUpdate{
+		Description:                          job.Description,
+		Groups:                               job.Groups,
+		ModelPlotConfig:                      job.ModelPlotConfig,
+		AllowLazyOpen:                        job.AllowLazyOpen,
+		BackgroundPersistInterval:            job.BackgroundPersistInterval,
+		CustomSettings:                       job.CustomSettings,
+		DailyModelSnapshotRetentionAfterDays: job.DailyModelSnapshotRetentionAfterDays,
+		ModelSnapshotRetentionDays:           job.ModelSnapshotRetentionDays,
+		RenormalizationWindowDays:            job.RenormalizationWindowDays,
+		ResultsRetentionDays:                 job.ResultsRetentionDays,
+	}
+	if job.AnalysisLimits != nil {
+		update.AnalysisLimits = &models.AnomalyDetectionLimits{ModelMemoryLimit: job.AnalysisLimits.ModelMemoryLimit}
+	}
+	// omitted values are left unchanged, empty ones reset them
+	if update.Groups == nil {
+		update.Groups = []string{}
+	}
+	if update.CustomSettings == nil {
+		update.CustomSettings = map[string]interface{}{}
+	}
+	jobBytes, err := json.Marshal(update)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().ML.UpdateJob(job.JobID, bytes.NewReader(jobBytes), apiClient.GetESClient().ML.UpdateJob.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to update anomaly detection job: %s", job.JobID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func GetAnomalyDetectionJob(ctx context.Context, apiClient *clients.ApiClient, jobID string) (*models.AnomalyDetectionJob, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().ML.GetJobs(
+		apiClient.GetESClient().ML.GetJobs.WithJobID(jobID),
+		apiClient.GetESClient().ML.GetJobs.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get anomaly detection job: %s", jobID)); diags.HasError() {
+		return nil, diags
+	}
+
+	var jobsResponse struct {
+		Jobs []struct {
+			JobID string `json:"job_id"`
+			models.AnomalyDetectionJob
+		} `json:"jobs"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&jobsResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, j := range jobsResponse.Jobs {
+		if j.JobID == jobID {
+			job := j.AnomalyDetectionJob
+			job.JobID = j.JobID
+			return &job, nil
+		}
+	}
+	return nil, nil
+}
+
+func GetAnomalyDetectionJobStats(ctx context.Context, apiClient *clients.ApiClient, jobID string) (*models.AnomalyDetectionJobStats, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().ML.GetJobStats(
+		apiClient.GetESClient().ML.GetJobStats.WithJobID(jobID),
+		apiClient.GetESClient().ML.GetJobStats.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get anomaly detection job stats: %s", jobID)); diags.HasError() {
+		return nil, diags
+	}
+
+	var statsResponse struct {
+		Jobs []models.AnomalyDetectionJobStats `json:"jobs"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&statsResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, s := range statsResponse.Jobs {
+		if s.JobID == jobID {
+			return &s, nil
+		}
+	}
+	return nil, diag.Errorf(`unable to find stats of anomaly detection job "%s" in the ES API response`, jobID)
+}
+
+func OpenAnomalyDetectionJob(ctx context.Context, apiClient *clients.ApiClient, jobID string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().ML.OpenJob(jobID, apiClient.GetESClient().ML.OpenJob.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to open anomaly detection job: %s", jobID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func CloseAnomalyDetectionJob(ctx context.Context, apiClient *clients.ApiClient, jobID string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().ML.CloseJob(jobID, apiClient.GetESClient().ML.CloseJob.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to close anomaly detection job: %s", jobID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func DeleteAnomalyDetectionJob(ctx context.Context, apiClient *clients.ApiClient, jobID string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().ML.DeleteJob(
+		jobID,
+		apiClient.GetESClient().ML.DeleteJob.WithForce(true),
+		apiClient.GetESClient().ML.DeleteJob.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to delete anomaly detection job: %s", jobID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func PutDatafeed(ctx context.Context, apiClient *clients.ApiClient, datafeed *models.Datafeed) diag.Diagnostics {
+	datafeedBytes, err := json.Marshal(datafeed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().ML.PutDatafeed(bytes.NewReader(datafeedBytes), datafeed.DatafeedID, apiClient.GetESClient().ML.PutDatafeed.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to create datafeed: %s", datafeed.DatafeedID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func UpdateDatafeed(ctx context.Context, apiClient *clients.ApiClient, datafeed *models.Datafeed) diag.Diagnostics {
+	update := models.DatafeedUpdate{
+		Indices:                datafeed.Indices,
+		Query:                  datafeed.Query,
+		Aggregations:           datafeed.Aggregations,
+		ScriptFields:           datafeed.ScriptFields,
+		RuntimeMappings:        datafeed.RuntimeMappings,
+		Frequency:              datafeed.Frequency,
+		QueryDelay:             datafeed.QueryDelay,
+		ScrollSize:             datafeed.ScrollSize,
+		MaxEmptySearches:       datafeed.MaxEmptySearches,
+		ChunkingConfig:         datafeed.ChunkingConfig,
+		DelayedDataCheckConfig: datafeed.DelayedDataCheckConfig,
+	}
+	// omitted values are left unchanged, empty ones reset them
+	if update.ScriptFields == nil {
+		update.ScriptFields = map[string]interface{}{}
+	}
+	if update.RuntimeMappings == nil {
+		update.RuntimeMappings = map[string]interface{}{}
+	}
+	datafeedBytes, err := json.Marshal(update)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().ML.UpdateDatafeed(bytes.NewReader(datafeedBytes), datafeed.DatafeedID, apiClient.GetESClient().ML.UpdateDatafeed.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to update datafeed: %s", datafeed.DatafeedID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func GetDatafeed(ctx context.Context, apiClient *clients.ApiClient, datafeedID string) (*models.Datafeed, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().ML.GetDatafeeds(
+		apiClient.GetESClient().ML.GetDatafeeds.WithDatafeedID(datafeedID),
+		apiClient.GetESClient().ML.GetDatafeeds.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get datafeed: %s", datafeedID)); diags.HasError() {
+		return nil, diags
+	}
+
+	var datafeedsResponse struct {
+		Datafeeds []struct {
+			DatafeedID string `json:"datafeed_id"`
+			models.Datafeed
+		} `json:"datafeeds"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&datafeedsResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, df := range datafeedsResponse.Datafeeds {
+		if df.DatafeedID == datafeedID {
+			datafeed := df.Datafeed
+			datafeed.DatafeedID = df.DatafeedID
+			return &datafeed, nil
+		}
+	}
+	return nil, nil
+}
+
+func GetDatafeedStats(ctx context.Context, apiClient *clients.ApiClient, datafeedID string) (*models.DatafeedStats, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().ML.GetDatafeedStats(
+		apiClient.GetESClient().ML.GetDatafeedStats.WithDatafeedID(datafeedID),
+		apiClient.GetESClient().ML.GetDatafeedStats.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get datafeed stats: %s", datafeedID)); diags.HasError() {
+		return nil, diags
+	}
+
+	var statsResponse struct {
+		Datafeeds []models.DatafeedStats `json:"datafeeds"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&statsResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, s := range statsResponse.Datafeeds {
+		if s.DatafeedID == datafeedID {
+			return &s, nil
+		}
+	}
+	return nil, diag.Errorf(`unable to find stats of datafeed "%s" in the ES API response`, datafeedID)
+}
+
+func StartDatafeed(ctx context.Context, apiClient *clients.ApiClient, datafeedID string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().ML.StartDatafeed(datafeedID, apiClient.GetESClient().ML.StartDatafeed.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to start datafeed: %s", datafeedID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func StopDatafeed(ctx context.Context, apiClient *clients.ApiClient, datafeedID string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().ML.StopDatafeed(datafeedID, apiClient.GetESClient().ML.StopDatafeed.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to stop datafeed: %s", datafeedID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func DeleteDatafeed(ctx context.Context, apiClient *clients.ApiClient, datafeedID string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().ML.DeleteDatafeed(
+		datafeedID,
+		apiClient.GetESClient().ML.DeleteDatafeed.WithForce(true),
+		apiClient.GetESClient().ML.DeleteDatafeed.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to delete datafeed: %s", datafeedID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+)
+
+// performRequest calls the endpoints which are not available in the version of the client in use
+func performRequest(ctx context.Context, apiClient *clients.ApiClient, method, path string, body []byte) (*esapi.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := apiClient.GetESClient().Perform(req)
+	if err != nil {
+		return nil, err
+	}
+	return &esapi.Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}, nil
+}
@@ -6,7 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 
+	"github.com/elastic/go-elasticsearch/v7/esapi"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
@@ -173,6 +177,229 @@ func GetRole(ctx context.Context, apiClient *clients.ApiClient, rolename string)
 	return nil, diags
 }
 
+func PutApplicationPrivilege(ctx context.Context, apiClient *clients.ApiClient, privilege *models.ApplicationPrivilege) diag.Diagnostics {
+	privileges := map[string]map[string]*models.ApplicationPrivilege{
+		privilege.Application: {privilege.Name: privilege},
+	}
+	privilegesBytes, err := json.Marshal(privileges)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().Security.PutPrivileges(bytes.NewReader(privilegesBytes), apiClient.GetESClient().Security.PutPrivileges.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to put application privilege"); diags.HasError() {
+		return diags
+	}
+
+	return nil
+}
+
+func GetApplicationPrivilege(ctx context.Context, apiClient *clients.ApiClient, application string, name string) (*models.ApplicationPrivilege, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().Security.GetPrivileges(
+		apiClient.GetESClient().Security.GetPrivileges.WithApplication(application),
+		apiClient.GetESClient().Security.GetPrivileges.WithName(name),
+		apiClient.GetESClient().Security.GetPrivileges.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, "Unable to get application privilege"); diags.HasError() {
+		return nil, diags
+	}
+	privileges := make(map[string]map[string]models.ApplicationPrivilege)
+	if err := json.NewDecoder(res.Body).Decode(&privileges); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if privilege, ok := privileges[application][name]; ok {
+		privilege.Application = application
+		privilege.Name = name
+		return &privilege, nil
+	}
+	return nil, diag.Errorf(`unable to find application privilege "%s" of application "%s" in the cluster`, name, application)
+}
+
+func DeleteApplicationPrivilege(ctx context.Context, apiClient *clients.ApiClient, application string, name string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().Security.DeletePrivileges(application, name, apiClient.GetESClient().Security.DeletePrivileges.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to delete application privilege"); diags.HasError() {
+		return diags
+	}
+
+	return nil
+}
+
+func CreateServiceToken(ctx context.Context, apiClient *clients.ApiClient, namespace string, service string, name string) (*models.ServiceToken, diag.Diagnostics) {
+	opts := []func(*esapi.SecurityCreateServiceTokenRequest){
+		apiClient.GetESClient().Security.CreateServiceToken.WithRefresh("wait_for"),
+		apiClient.GetESClient().Security.CreateServiceToken.WithContext(ctx),
+	}
+	if name != "" {
+		opts = append(opts, apiClient.GetESClient().Security.CreateServiceToken.WithName(name))
+	}
+	res, err := apiClient.GetESClient().Security.CreateServiceToken(namespace, service, opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to create service token"); diags.HasError() {
+		return nil, diags
+	}
+
+	var tokenResponse struct {
+		Token models.ServiceToken `json:"token"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&tokenResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &tokenResponse.Token, nil
+}
+
+func GetServiceCredentials(ctx context.Context, apiClient *clients.ApiClient, namespace string, service string) (*models.ServiceCredentials, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().Security.GetServiceCredentials(namespace, service, apiClient.GetESClient().Security.GetServiceCredentials.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, "Unable to get service credentials"); diags.HasError() {
+		return nil, diags
+	}
+
+	var credentials models.ServiceCredentials
+	if err := json.NewDecoder(res.Body).Decode(&credentials); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &credentials, nil
+}
+
+func DeleteServiceToken(ctx context.Context, apiClient *clients.ApiClient, namespace string, service string, name string) diag.Diagnostics {
+	// the arguments of Security.DeleteServiceToken are not in the order of its declared type
+	req := esapi.SecurityDeleteServiceTokenRequest{
+		Namespace: namespace,
+		Service:   service,
+		Name:      name,
+		Refresh:   "wait_for",
+	}
+	res, err := req.Do(ctx, apiClient.GetESClient())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to delete service token"); diags.HasError() {
+		return diags
+	}
+
+	return nil
+}
+
+func GetServiceAccounts(ctx context.Context, apiClient *clients.ApiClient, namespace string, service string) (map[string]models.ServiceAccount, diag.Diagnostics) {
+	opts := []func(*esapi.SecurityGetServiceAccountsRequest){
+		apiClient.GetESClient().Security.GetServiceAccounts.WithContext(ctx),
+	}
+	if namespace != "" {
+		opts = append(opts, apiClient.GetESClient().Security.GetServiceAccounts.WithNamespace(namespace))
+	}
+	if service != "" {
+		opts = append(opts, apiClient.GetESClient().Security.GetServiceAccounts.WithService(service))
+	}
+	res, err := apiClient.GetESClient().Security.GetServiceAccounts(opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get service accounts"); diags.HasError() {
+		return nil, diags
+	}
+
+	accounts := make(map[string]models.ServiceAccount)
+	if err := json.NewDecoder(res.Body).Decode(&accounts); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return accounts, nil
+}
+
+// HasPrivileges checks the privileges of the user of the client, or of the user it runs as, or of the API key when set
+func HasPrivileges(ctx context.Context, apiClient *clients.ApiClient, privileges *models.HasPrivileges, runAs string, apiKey string) (*models.HasPrivilegesResponse, diag.Diagnostics) {
+	privilegesBytes, err := json.Marshal(privileges)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	headers := make(map[string]string)
+	if runAs != "" {
+		headers["es-security-runas-user"] = runAs
+	}
+	if apiKey != "" {
+		// replaces the credentials of the client
+		headers["Authorization"] = fmt.Sprintf("ApiKey %s", apiKey)
+	}
+	res, err := apiClient.GetESClient().Security.HasPrivileges(
+		bytes.NewReader(privilegesBytes),
+		apiClient.GetESClient().Security.HasPrivileges.WithContext(ctx),
+		apiClient.GetESClient().Security.HasPrivileges.WithHeader(headers),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to check the privileges"); diags.HasError() {
+		return nil, diags
+	}
+
+	var response models.HasPrivilegesResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &response, nil
+}
+
+var (
+	builtinPrivilegesMutex sync.Mutex
+	// the built-in privileges only change with the version of the cluster, they are cached by cluster
+	builtinPrivilegesCache = make(map[string]*models.BuiltinPrivileges)
+)
+
+func GetBuiltinPrivileges(ctx context.Context, apiClient *clients.ApiClient) (*models.BuiltinPrivileges, diag.Diagnostics) {
+	clusterId, diags := apiClient.ClusterID(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	builtinPrivilegesMutex.Lock()
+	defer builtinPrivilegesMutex.Unlock()
+	if privileges, ok := builtinPrivilegesCache[*clusterId]; ok {
+		return privileges, nil
+	}
+
+	res, err := apiClient.GetESClient().Security.GetBuiltinPrivileges(apiClient.GetESClient().Security.GetBuiltinPrivileges.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get the built-in privileges"); diags.HasError() {
+		return nil, diags
+	}
+	var privileges models.BuiltinPrivileges
+	if err := json.NewDecoder(res.Body).Decode(&privileges); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	builtinPrivilegesCache[*clusterId] = &privileges
+	return &privileges, nil
+}
+
 func DeleteRole(ctx context.Context, apiClient *clients.ApiClient, rolename string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	res, err := apiClient.GetESClient().Security.DeleteRole(rolename, apiClient.GetESClient().Security.DeleteRole.WithContext(ctx))
@@ -244,14 +471,14 @@ func DeleteRoleMapping(ctx context.Context, apiClient *clients.ApiClient, roleMa
 	return nil
 }
 
-func PutApiKey(apiClient *clients.ApiClient, apikey *models.ApiKey) (*models.ApiKeyResponse, diag.Diagnostics) {
+func PutApiKey(ctx context.Context, apiClient *clients.ApiClient, apikey *models.ApiKey) (*models.ApiKeyResponse, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	apikeyBytes, err := json.Marshal(apikey)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
 
-	res, err := apiClient.GetESClient().Security.CreateAPIKey(bytes.NewReader(apikeyBytes))
+	res, err := apiClient.GetESClient().Security.CreateAPIKey(bytes.NewReader(apikeyBytes), apiClient.GetESClient().Security.CreateAPIKey.WithContext(ctx))
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
@@ -269,21 +496,16 @@ func PutApiKey(apiClient *clients.ApiClient, apikey *models.ApiKey) (*models.Api
 	return &apiKey, diags
 }
 
-func GetApiKey(apiClient *clients.ApiClient, id string) (*models.ApiKeyResponse, diag.Diagnostics) {
+func GetApiKey(ctx context.Context, apiClient *clients.ApiClient, id string) (*models.ApiKeyResponse, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	req := apiClient.GetESClient().Security.GetAPIKey.WithID(id)
-	res, err := apiClient.GetESClient().Security.GetAPIKey(req)
+	res, err := apiClient.GetESClient().Security.GetAPIKey(req, apiClient.GetESClient().Security.GetAPIKey.WithContext(ctx))
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
 	defer res.Body.Close()
 	if res.StatusCode == http.StatusNotFound {
-		diags := append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to find an apikey in the cluster.",
-			Detail:   fmt.Sprintf("Unable to get apikey: '%s' from the cluster.", id),
-		})
-		return nil, diags
+		return nil, nil
 	}
 	if diags := utils.CheckError(res, "Unable to get an apikey."); diags.HasError() {
 		return nil, diags
@@ -310,7 +532,166 @@ func GetApiKey(apiClient *clients.ApiClient, id string) (*models.ApiKeyResponse,
 	return &apiKey, diags
 }
 
-func DeleteApiKey(apiClient *clients.ApiClient, id string) diag.Diagnostics {
+func GrantApiKey(ctx context.Context, apiClient *clients.ApiClient, grant *models.ApiKeyGrant) (*models.ApiKeyResponse, diag.Diagnostics) {
+	grantBytes, err := json.Marshal(grant)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	res, err := apiClient.GetESClient().Security.GrantAPIKey(bytes.NewReader(grantBytes), apiClient.GetESClient().Security.GrantAPIKey.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to grant apikey"); diags.HasError() {
+		return nil, diags
+	}
+
+	var apiKey models.ApiKeyResponse
+	if err := json.NewDecoder(res.Body).Decode(&apiKey); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &apiKey, nil
+}
+
+func PutCrossClusterApiKey(ctx context.Context, apiClient *clients.ApiClient, apikey *models.CrossClusterApiKey) (*models.ApiKeyResponse, diag.Diagnostics) {
+	apikeyBytes, err := json.Marshal(apikey)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	res, err := performRequest(ctx, apiClient, http.MethodPost, "/_security/cross_cluster/api_key", apikeyBytes)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to create cross-cluster apikey"); diags.HasError() {
+		return nil, diags
+	}
+
+	var apiKey models.ApiKeyResponse
+	if err := json.NewDecoder(res.Body).Decode(&apiKey); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &apiKey, nil
+}
+
+func UpdateCrossClusterApiKey(ctx context.Context, apiClient *clients.ApiClient, id string, apikey *models.CrossClusterApiKey) diag.Diagnostics {
+	// the name and the expiration cannot be updated
+	update := map[string]interface{}{
+		"access":   apikey.Access,
+		"metadata": map[string]interface{}{},
+	}
+	if apikey.Metadata != nil {
+		update["metadata"] = apikey.Metadata
+	}
+	updateBytes, err := json.Marshal(update)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := performRequest(ctx, apiClient, http.MethodPut, fmt.Sprintf("/_security/cross_cluster/api_key/%s", url.PathEscape(id)), updateBytes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to update cross-cluster apikey: %s", id)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func UpdateApiKey(ctx context.Context, apiClient *clients.ApiClient, id string, apikey *models.ApiKey) diag.Diagnostics {
+	// empty values must be sent to clear the role descriptors and the metadata of the key
+	update := map[string]interface{}{
+		"role_descriptors": map[string]models.Role{},
+		"metadata":         map[string]interface{}{},
+	}
+	if apikey.RolesDescriptors != nil {
+		update["role_descriptors"] = apikey.RolesDescriptors
+	}
+	if apikey.Metadata != nil {
+		update["metadata"] = apikey.Metadata
+	}
+	updateBytes, err := json.Marshal(update)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := performRequest(ctx, apiClient, http.MethodPut, fmt.Sprintf("/_security/api_key/%s", url.PathEscape(id)), updateBytes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to update apikey: %s", id)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func QueryApiKeys(ctx context.Context, apiClient *clients.ApiClient, params *models.QueryApiKeysParams) ([]models.ApiKeyResponse, diag.Diagnostics) {
+	filters := make([]interface{}, 0)
+	if params.Name != "" {
+		if strings.Contains(params.Name, "*") {
+			filters = append(filters, map[string]interface{}{"wildcard": map[string]interface{}{"name": params.Name}})
+		} else {
+			filters = append(filters, map[string]interface{}{"term": map[string]interface{}{"name": params.Name}})
+		}
+	}
+	if params.Owner != "" {
+		filters = append(filters, map[string]interface{}{"term": map[string]interface{}{"username": params.Owner}})
+	}
+	if params.Realm != "" {
+		filters = append(filters, map[string]interface{}{"term": map[string]interface{}{"realm_name": params.Realm}})
+	}
+	for k, v := range params.Metadata {
+		filters = append(filters, map[string]interface{}{"term": map[string]interface{}{fmt.Sprintf("metadata.%s", k): v}})
+	}
+	if params.ExpiresBefore != nil {
+		filters = append(filters, map[string]interface{}{"range": map[string]interface{}{
+			"expiration": map[string]interface{}{"lte": params.ExpiresBefore.UnixMilli()},
+		}})
+	}
+	if !params.IncludeInvalidated {
+		filters = append(filters, map[string]interface{}{"term": map[string]interface{}{"invalidated": false}})
+	}
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{"filter": filters},
+		},
+		// the keys which expire first come first, the ones which never expire last
+		"sort": []interface{}{"expiration", "name"},
+	}
+	if params.Size > 0 {
+		query["size"] = params.Size
+	}
+	queryBytes, err := json.Marshal(query)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	res, err := apiClient.GetESClient().Security.QueryAPIKeys(
+		apiClient.GetESClient().Security.QueryAPIKeys.WithBody(bytes.NewReader(queryBytes)),
+		apiClient.GetESClient().Security.QueryAPIKeys.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to query apikeys"); diags.HasError() {
+		return nil, diags
+	}
+
+	var apiKeys struct {
+		ApiKeys []models.ApiKeyResponse `json:"api_keys"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&apiKeys); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return apiKeys.ApiKeys, nil
+}
+
+func DeleteApiKey(ctx context.Context, apiClient *clients.ApiClient, id string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	apiKeys := struct {
@@ -323,8 +704,8 @@ func DeleteApiKey(apiClient *clients.ApiClient, id string) diag.Diagnostics {
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	res, err := apiClient.GetESClient().Security.InvalidateAPIKey(bytes.NewReader(apikeyBytes))
-	if err != nil && res.IsError() {
+	res, err := apiClient.GetESClient().Security.InvalidateAPIKey(bytes.NewReader(apikeyBytes), apiClient.GetESClient().Security.InvalidateAPIKey.WithContext(ctx))
+	if err != nil {
 		return diag.FromErr(err)
 	}
 	defer res.Body.Close()
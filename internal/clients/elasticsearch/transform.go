@@ -0,0 +1,164 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func PutTransform(ctx context.Context, apiClient *clients.ApiClient, transform *models.Transform, deferValidation bool) diag.Diagnostics {
+	transformBytes, err := json.Marshal(transform)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().TransformPutTransform(
+		bytes.NewReader(transformBytes),
+		transform.Id,
+		apiClient.GetESClient().TransformPutTransform.WithDeferValidation(deferValidation),
+		apiClient.GetESClient().TransformPutTransform.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to create transform: %s", transform.Id)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+// Only a subset of the transform definition can be updated, the pivot and latest configurations must be left out of the request
+func UpdateTransform(ctx context.Context, apiClient *clients.ApiClient, transform *models.Transform, deferValidation bool) diag.Diagnostics {
+	update := *transform
+	update.Pivot = nil
+	update.Latest = nil
+	transformBytes, err := json.Marshal(update)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().TransformUpdateTransform(
+		bytes.NewReader(transformBytes),
+		transform.Id,
+		apiClient.GetESClient().TransformUpdateTransform.WithDeferValidation(deferValidation),
+		apiClient.GetESClient().TransformUpdateTransform.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to update transform: %s", transform.Id)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func GetTransform(ctx context.Context, apiClient *clients.ApiClient, name string) (*models.Transform, diag.Diagnostics) {
+	req := apiClient.GetESClient().TransformGetTransform.WithTransformID(name)
+	res, err := apiClient.GetESClient().TransformGetTransform(req, apiClient.GetESClient().TransformGetTransform.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get transform: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+
+	var transformsResponse struct {
+		Transforms []struct {
+			Id string `json:"id"`
+			models.Transform
+		} `json:"transforms"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&transformsResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, t := range transformsResponse.Transforms {
+		if t.Id == name {
+			transform := t.Transform
+			transform.Id = name
+			return &transform, nil
+		}
+	}
+	return nil, diag.Errorf(`unable to find transform "%s" in the ES API response`, name)
+}
+
+func GetTransformStats(ctx context.Context, apiClient *clients.ApiClient, name string) (*models.TransformStats, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().TransformGetTransformStats(name, apiClient.GetESClient().TransformGetTransformStats.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get transform stats: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+
+	var statsResponse struct {
+		Transforms []models.TransformStats `json:"transforms"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&statsResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, s := range statsResponse.Transforms {
+		if s.Id == name {
+			return &s, nil
+		}
+	}
+	return nil, diag.Errorf(`unable to find stats of transform "%s" in the ES API response`, name)
+}
+
+func StartTransform(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().TransformStartTransform(name, apiClient.GetESClient().TransformStartTransform.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to start transform: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func StopTransform(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().TransformStopTransform(
+		name,
+		apiClient.GetESClient().TransformStopTransform.WithWaitForCompletion(true),
+		apiClient.GetESClient().TransformStopTransform.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to stop transform: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func DeleteTransform(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().TransformDeleteTransform(
+		name,
+		apiClient.GetESClient().TransformDeleteTransform.WithForce(true),
+		apiClient.GetESClient().TransformDeleteTransform.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to delete transform: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
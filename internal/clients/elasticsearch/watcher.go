@@ -0,0 +1,98 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func PutWatch(ctx context.Context, apiClient *clients.ApiClient, watch *models.Watch) diag.Diagnostics {
+	watchBytes, err := json.Marshal(watch)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().Watcher.PutWatch(
+		watch.WatchID,
+		apiClient.GetESClient().Watcher.PutWatch.WithBody(bytes.NewReader(watchBytes)),
+		apiClient.GetESClient().Watcher.PutWatch.WithActive(watch.Active),
+		apiClient.GetESClient().Watcher.PutWatch.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to create or update watch"); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func GetWatch(ctx context.Context, apiClient *clients.ApiClient, watchID string) (*models.Watch, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().Watcher.GetWatch(watchID, apiClient.GetESClient().Watcher.GetWatch.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get watch: %s", watchID)); diags.HasError() {
+		return nil, diags
+	}
+
+	var watchResponse models.WatchResponse
+	if err := json.NewDecoder(res.Body).Decode(&watchResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if !watchResponse.Found {
+		return nil, nil
+	}
+
+	watch := watchResponse.Watch
+	watch.WatchID = watchID
+	watch.Active = watchResponse.Status.State.Active
+	return &watch, nil
+}
+
+func DeleteWatch(ctx context.Context, apiClient *clients.ApiClient, watchID string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().Watcher.DeleteWatch(watchID, apiClient.GetESClient().Watcher.DeleteWatch.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to delete watch: %s", watchID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func ActivateWatch(ctx context.Context, apiClient *clients.ApiClient, watchID string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().Watcher.ActivateWatch(watchID, apiClient.GetESClient().Watcher.ActivateWatch.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to activate watch: %s", watchID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func DeactivateWatch(ctx context.Context, apiClient *clients.ApiClient, watchID string) diag.Diagnostics {
+	res, err := apiClient.GetESClient().Watcher.DeactivateWatch(watchID, apiClient.GetESClient().Watcher.DeactivateWatch.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to deactivate watch: %s", watchID)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
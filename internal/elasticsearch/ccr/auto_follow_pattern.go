@@ -0,0 +1,214 @@
+package ccr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceAutoFollowPattern() *schema.Resource {
+	patternSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the auto-follow pattern.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"remote_cluster": {
+			Description: "The remote cluster containing the leader indices to match against.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"leader_index_patterns": {
+			Description: "An array of simple index patterns to match against indices in the remote cluster.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"leader_index_exclusion_patterns": {
+			Description: "An array of simple index patterns that can be used to exclude indices from being auto-followed. Supported from Elasticsearch version **7.14**",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"follow_index_pattern": {
+			Description: "The name of follower index. The template `{{leader_index}}` can be used to derive the name of the follower index from the name of the leader index.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"settings": {
+			Description:      "Settings to override from the leader index.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffIndexSettingSuppress,
+		},
+		"active": {
+			Description: "Whether the auto-follow pattern is active. Paused patterns do not create new follower indices.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+	}
+	addFollowParametersSchema(patternSchema)
+
+	utils.AddConnectionSchema(patternSchema)
+
+	return &schema.Resource{
+		Description: "Creates and manages a cross-cluster replication auto-follow pattern. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/ccr-put-auto-follow-pattern.html",
+
+		CreateContext: resourceAutoFollowPatternPut,
+		UpdateContext: resourceAutoFollowPatternPut,
+		ReadContext:   resourceAutoFollowPatternRead,
+		DeleteContext: resourceAutoFollowPatternDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: patternSchema,
+	}
+}
+
+func resourceAutoFollowPatternPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	name := d.Get("name").(string)
+	id, diags := client.ID(ctx, name)
+	if diags.HasError() {
+		return diags
+	}
+
+	pattern := models.AutoFollowPattern{
+		Name:               name,
+		RemoteCluster:      d.Get("remote_cluster").(string),
+		FollowIndexPattern: d.Get("follow_index_pattern").(string),
+		FollowParameters:   expandFollowParameters(d),
+	}
+	for _, p := range d.Get("leader_index_patterns").([]interface{}) {
+		pattern.LeaderIndexPatterns = append(pattern.LeaderIndexPatterns, p.(string))
+	}
+	for _, p := range d.Get("leader_index_exclusion_patterns").([]interface{}) {
+		pattern.LeaderIndexExclusionPatterns = append(pattern.LeaderIndexExclusionPatterns, p.(string))
+	}
+	if v, ok := d.GetOk("settings"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &pattern.Settings); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if diags := elasticsearch.PutAutoFollowPattern(ctx, client, &pattern); diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	current, diags := elasticsearch.GetAutoFollowPattern(ctx, client, name)
+	if diags.HasError() {
+		return diags
+	}
+	if active := d.Get("active").(bool); current != nil && current.Active != active {
+		if active {
+			diags = elasticsearch.ResumeAutoFollowPattern(ctx, client, name)
+		} else {
+			diags = elasticsearch.PauseAutoFollowPattern(ctx, client, name)
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceAutoFollowPatternRead(ctx, d, meta)
+}
+
+func resourceAutoFollowPatternRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	name := compId.ResourceId
+
+	pattern, diags := elasticsearch.GetAutoFollowPattern(ctx, client, name)
+	if pattern == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Auto-follow pattern "%s" not found, removing from state`, name))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("name", name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("remote_cluster", pattern.RemoteCluster); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("leader_index_patterns", pattern.LeaderIndexPatterns); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("leader_index_exclusion_patterns", pattern.LeaderIndexExclusionPatterns); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("follow_index_pattern", pattern.FollowIndexPattern); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("active", pattern.Active); err != nil {
+		return diag.FromErr(err)
+	}
+	if pattern.Settings != nil {
+		settings, err := json.Marshal(pattern.Settings)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("settings", string(settings)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if diags := flattenFollowParameters(d, &pattern.FollowParameters); diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+func resourceAutoFollowPatternDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeleteAutoFollowPattern(ctx, client, compId.ResourceId); diags.HasError() {
+		return diags
+	}
+	return diags
+}
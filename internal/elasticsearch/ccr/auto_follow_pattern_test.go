@@ -0,0 +1,113 @@
+package ccr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceAutoFollowPattern(t *testing.T) {
+	// generate a random name
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceAutoFollowPatternDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAutoFollowPatternCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_auto_follow_pattern.test", "name", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_auto_follow_pattern.test", "remote_cluster", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_auto_follow_pattern.test", "leader_index_patterns.0", fmt.Sprintf("%s-leader-*", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_auto_follow_pattern.test", "follow_index_pattern", "{{leader_index}}-follower"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_auto_follow_pattern.test", "active", "true"),
+				),
+			},
+			{
+				Config: testAccResourceAutoFollowPatternUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_auto_follow_pattern.test", "leader_index_patterns.#", "2"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_auto_follow_pattern.test", "max_outstanding_read_requests", "6"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_auto_follow_pattern.test", "read_poll_timeout", "30s"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_auto_follow_pattern.test", "active", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceAutoFollowPatternCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_remote_cluster" "test" {
+  name  = "%s"
+  seeds = ["127.0.0.1:9300"]
+}
+
+resource "elasticstack_elasticsearch_ccr_auto_follow_pattern" "test" {
+  name                  = "%s"
+  remote_cluster        = elasticstack_elasticsearch_remote_cluster.test.name
+  leader_index_patterns = ["%s-leader-*"]
+  follow_index_pattern  = "{{leader_index}}-follower"
+}
+	`, name, name, name)
+}
+
+func testAccResourceAutoFollowPatternUpdate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_remote_cluster" "test" {
+  name  = "%s"
+  seeds = ["127.0.0.1:9300"]
+}
+
+resource "elasticstack_elasticsearch_ccr_auto_follow_pattern" "test" {
+  name                  = "%s"
+  remote_cluster        = elasticstack_elasticsearch_remote_cluster.test.name
+  leader_index_patterns = ["%s-leader-*", "%s-other-*"]
+  follow_index_pattern  = "{{leader_index}}-follower"
+  active                = false
+
+  max_outstanding_read_requests = 6
+  read_poll_timeout             = "30s"
+}
+	`, name, name, name, name)
+}
+
+func checkResourceAutoFollowPatternDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_ccr_auto_follow_pattern" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+
+		pattern, diags := elasticsearch.GetAutoFollowPattern(context.Background(), client, compId.ResourceId)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get auto-follow pattern %v", diags)
+		}
+		if pattern != nil {
+			return fmt.Errorf("Auto-follow pattern (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
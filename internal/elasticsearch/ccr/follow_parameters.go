@@ -0,0 +1,121 @@
+package ccr
+
+import (
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The tuning parameters shared by follower indices and auto-follow patterns
+var followParameterNames = []string{
+	"max_read_request_operation_count",
+	"max_outstanding_read_requests",
+	"max_read_request_size",
+	"max_write_request_operation_count",
+	"max_write_request_size",
+	"max_outstanding_write_requests",
+	"max_write_buffer_count",
+	"max_write_buffer_size",
+	"max_retry_delay",
+	"read_poll_timeout",
+}
+
+func addFollowParametersSchema(s map[string]*schema.Schema) {
+	intParam := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Description:  description,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		}
+	}
+	stringParam := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Description: description,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		}
+	}
+
+	s["max_read_request_operation_count"] = intParam("The maximum number of operations to pull per read from the remote cluster.")
+	s["max_outstanding_read_requests"] = intParam("The maximum number of outstanding reads requests from the remote cluster.")
+	s["max_read_request_size"] = stringParam("The maximum size in bytes of per read of a batch of operations pulled from the remote cluster, e.g. `32mb`.")
+	s["max_write_request_operation_count"] = intParam("The maximum number of operations per bulk write request executed on the follower.")
+	s["max_write_request_size"] = stringParam("The maximum total bytes of operations per bulk write request executed on the follower.")
+	s["max_outstanding_write_requests"] = intParam("The maximum number of outstanding write requests on the follower.")
+	s["max_write_buffer_count"] = intParam("The maximum number of operations that can be queued for writing.")
+	s["max_write_buffer_size"] = stringParam("The maximum total bytes of operations that can be queued for writing.")
+	s["max_retry_delay"] = stringParam("The maximum time to wait before retrying an operation that failed exceptionally, e.g. `500ms`.")
+	s["read_poll_timeout"] = stringParam("The maximum time to wait for new operations on the remote cluster when the follower index is synchronized with the leader index, e.g. `1m`.")
+}
+
+func expandFollowParameters(d *schema.ResourceData) models.FollowParameters {
+	params := models.FollowParameters{}
+
+	ints := map[string]**int{
+		"max_read_request_operation_count":  &params.MaxReadRequestOperationCount,
+		"max_outstanding_read_requests":     &params.MaxOutstandingReadRequests,
+		"max_write_request_operation_count": &params.MaxWriteRequestOperationCount,
+		"max_outstanding_write_requests":    &params.MaxOutstandingWriteRequests,
+		"max_write_buffer_count":            &params.MaxWriteBufferCount,
+	}
+	for key, field := range ints {
+		if v, ok := d.GetOk(key); ok {
+			i := v.(int)
+			*field = &i
+		}
+	}
+
+	strs := map[string]*string{
+		"max_read_request_size":  &params.MaxReadRequestSize,
+		"max_write_request_size": &params.MaxWriteRequestSize,
+		"max_write_buffer_size":  &params.MaxWriteBufferSize,
+		"max_retry_delay":        &params.MaxRetryDelay,
+		"read_poll_timeout":      &params.ReadPollTimeout,
+	}
+	for key, field := range strs {
+		if v, ok := d.GetOk(key); ok {
+			*field = v.(string)
+		}
+	}
+
+	return params
+}
+
+func flattenFollowParameters(d *schema.ResourceData, params *models.FollowParameters) diag.Diagnostics {
+	ints := map[string]*int{
+		"max_read_request_operation_count":  params.MaxReadRequestOperationCount,
+		"max_outstanding_read_requests":     params.MaxOutstandingReadRequests,
+		"max_write_request_operation_count": params.MaxWriteRequestOperationCount,
+		"max_outstanding_write_requests":    params.MaxOutstandingWriteRequests,
+		"max_write_buffer_count":            params.MaxWriteBufferCount,
+	}
+	for key, v := range ints {
+		if v == nil {
+			continue
+		}
+		if err := d.Set(key, *v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	strs := map[string]string{
+		"max_read_request_size":  params.MaxReadRequestSize,
+		"max_write_request_size": params.MaxWriteRequestSize,
+		"max_write_buffer_size":  params.MaxWriteBufferSize,
+		"max_retry_delay":        params.MaxRetryDelay,
+		"read_poll_timeout":      params.ReadPollTimeout,
+	}
+	for key, v := range strs {
+		if v == "" {
+			continue
+		}
+		if err := d.Set(key, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
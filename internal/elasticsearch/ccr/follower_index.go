@@ -0,0 +1,224 @@
+package ccr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceFollowerIndex() *schema.Resource {
+	followerSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the follower index.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"remote_cluster": {
+			Description: "The remote cluster containing the leader index.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"leader_index": {
+			Description: "The name of the index in the leader cluster to follow.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"settings": {
+			Description:      "Settings to override from the leader index. Only used when creating the follower index.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffIndexSettingSuppress,
+		},
+		"paused": {
+			Description: "Whether the replication of the follower index is paused. Changes to the tuning parameters are applied when the replication is resumed.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"status": {
+			Description: "The status of the follower index, either `active` or `paused`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+	addFollowParametersSchema(followerSchema)
+
+	utils.AddConnectionSchema(followerSchema)
+
+	return &schema.Resource{
+		Description: "Creates and manages a cross-cluster replication follower index. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/ccr-put-follow.html",
+
+		CreateContext: resourceFollowerIndexCreate,
+		UpdateContext: resourceFollowerIndexUpdate,
+		ReadContext:   resourceFollowerIndexRead,
+		DeleteContext: resourceFollowerIndexDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: followerSchema,
+	}
+}
+
+func resourceFollowerIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	name := d.Get("name").(string)
+	id, diags := client.ID(ctx, name)
+	if diags.HasError() {
+		return diags
+	}
+
+	follower := models.FollowerIndex{
+		Name:             name,
+		RemoteCluster:    d.Get("remote_cluster").(string),
+		LeaderIndex:      d.Get("leader_index").(string),
+		FollowParameters: expandFollowParameters(d),
+	}
+	if v, ok := d.GetOk("settings"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &follower.Settings); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if diags := elasticsearch.FollowIndex(ctx, client, &follower); diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if d.Get("paused").(bool) {
+		if diags := elasticsearch.PauseFollowIndex(ctx, client, name); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceFollowerIndexRead(ctx, d, meta)
+}
+
+func resourceFollowerIndexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	name := compId.ResourceId
+
+	wasPaused := d.Get("status").(string) == "paused"
+	paused := d.Get("paused").(bool)
+	paramsChanged := d.HasChanges(followParameterNames...)
+
+	// the tuning parameters can only be changed by pausing and resuming the replication
+	if !wasPaused && (paused || paramsChanged) {
+		if diags := elasticsearch.PauseFollowIndex(ctx, client, name); diags.HasError() {
+			return diags
+		}
+	}
+	if !paused && (wasPaused || paramsChanged) {
+		params := expandFollowParameters(d)
+		if diags := elasticsearch.ResumeFollowIndex(ctx, client, name, &params); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceFollowerIndexRead(ctx, d, meta)
+}
+
+func resourceFollowerIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	name := compId.ResourceId
+
+	info, diags := elasticsearch.GetFollowerIndexInfo(ctx, client, name)
+	if info == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Follower index "%s" not found, removing from state`, name))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("name", info.FollowerIndex); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("remote_cluster", info.RemoteCluster); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("leader_index", info.LeaderIndex); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", info.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("paused", info.Status == "paused"); err != nil {
+		return diag.FromErr(err)
+	}
+	// the parameters are only returned while the replication is active
+	if info.Parameters != nil {
+		if diags := flattenFollowParameters(d, info.Parameters); diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFollowerIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	name := compId.ResourceId
+
+	// converting a follower index into a regular index requires it to be paused and closed
+	if d.Get("status").(string) != "paused" {
+		if diags := elasticsearch.PauseFollowIndex(ctx, client, name); diags.HasError() {
+			return diags
+		}
+	}
+	if diags := elasticsearch.CloseIndex(ctx, client, name); diags.HasError() {
+		return diags
+	}
+	if diags := elasticsearch.UnfollowIndex(ctx, client, name); diags.HasError() {
+		return diags
+	}
+	if diags := elasticsearch.OpenIndex(ctx, client, name); diags.HasError() {
+		return diags
+	}
+	return diags
+}
@@ -0,0 +1,104 @@
+package ccr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceFollowerIndex(t *testing.T) {
+	// generate a random name
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceFollowerIndexDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceFollowerIndex(name, false, 1000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_follower_index.test", "name", fmt.Sprintf("%s-follower", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_follower_index.test", "remote_cluster", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_follower_index.test", "leader_index", fmt.Sprintf("%s-leader", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_follower_index.test", "max_read_request_operation_count", "1000"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_follower_index.test", "status", "active"),
+				),
+			},
+			{
+				Config: testAccResourceFollowerIndex(name, false, 2000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_follower_index.test", "max_read_request_operation_count", "2000"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_follower_index.test", "status", "active"),
+				),
+			},
+			{
+				Config: testAccResourceFollowerIndex(name, true, 2000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_follower_index.test", "paused", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ccr_follower_index.test", "status", "paused"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceFollowerIndex(name string, paused bool, maxReadRequestOperationCount int) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_remote_cluster" "test" {
+  name                = "%s"
+  seeds               = ["127.0.0.1:9300"]
+  wait_for_connection = true
+}
+
+resource "elasticstack_elasticsearch_index" "leader" {
+  name = "%s-leader"
+}
+
+resource "elasticstack_elasticsearch_ccr_follower_index" "test" {
+  name           = "%s-follower"
+  remote_cluster = elasticstack_elasticsearch_remote_cluster.test.name
+  leader_index   = elasticstack_elasticsearch_index.leader.name
+  paused         = %t
+
+  max_read_request_operation_count = %d
+}
+	`, name, name, name, paused, maxReadRequestOperationCount)
+}
+
+func checkResourceFollowerIndexDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_ccr_follower_index" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+
+		info, diags := elasticsearch.GetFollowerIndexInfo(context.Background(), client, compId.ResourceId)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get follower index %v", diags)
+		}
+		if info != nil {
+			return fmt.Errorf("Index (%s) is still a follower index", compId.ResourceId)
+		}
+		if diags := elasticsearch.DeleteIndex(context.Background(), client, compId.ResourceId); diags.HasError() {
+			return fmt.Errorf("Unable to clean up the unfollowed index %v", diags)
+		}
+	}
+	return nil
+}
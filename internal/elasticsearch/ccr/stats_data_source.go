@@ -0,0 +1,182 @@
+package ccr
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceStats() *schema.Resource {
+	statsSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"auto_follow_stats": {
+			Description: "Statistics about the auto-follow coordinator.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"number_of_failed_follow_indices": {
+						Description: "The number of indices that the auto-follow coordinator failed to automatically follow.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"number_of_failed_remote_cluster_state_requests": {
+						Description: "The number of times that the auto-follow coordinator failed to retrieve the cluster state from a remote cluster.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"number_of_successful_follow_indices": {
+						Description: "The number of indices that the auto-follow coordinator successfully followed.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"follow_stats": {
+			Description: "Shard-level statistics of the follower indices.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"index": {
+						Description: "The name of the follower index.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"shards": {
+						Description: "The statistics of the shards of the follower index.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"shard_id": {
+									Description: "The numerical shard ID.",
+									Type:        schema.TypeInt,
+									Computed:    true,
+								},
+								"remote_cluster": {
+									Description: "The remote cluster containing the leader index.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"leader_index": {
+									Description: "The name of the index in the leader cluster being followed.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"leader_global_checkpoint": {
+									Description: "The current global checkpoint on the leader known to the follower task.",
+									Type:        schema.TypeInt,
+									Computed:    true,
+								},
+								"follower_global_checkpoint": {
+									Description: "The current global checkpoint on the follower.",
+									Type:        schema.TypeInt,
+									Computed:    true,
+								},
+								"operations_read": {
+									Description: "The total number of operations read from the leader.",
+									Type:        schema.TypeInt,
+									Computed:    true,
+								},
+								"operations_written": {
+									Description: "The number of operations written on the follower.",
+									Type:        schema.TypeInt,
+									Computed:    true,
+								},
+								"failed_read_requests": {
+									Description: "The number of failed reads.",
+									Type:        schema.TypeInt,
+									Computed:    true,
+								},
+								"failed_write_requests": {
+									Description: "The number of failed bulk write requests executed on the follower.",
+									Type:        schema.TypeInt,
+									Computed:    true,
+								},
+								"time_since_last_read_millis": {
+									Description: "The number of milliseconds since a read request was sent to the leader.",
+									Type:        schema.TypeInt,
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(statsSchema)
+
+	return &schema.Resource{
+		Description: "Gets cross-cluster replication stats. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/ccr-get-stats.html",
+
+		ReadContext: dataSourceStatsRead,
+
+		Schema: statsSchema,
+	}
+}
+
+func dataSourceStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	id, diags := client.ID(ctx, "ccr-stats")
+	if diags.HasError() {
+		return diags
+	}
+
+	stats, diags := elasticsearch.GetCcrStats(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	autoFollowStats := map[string]interface{}{
+		"number_of_failed_follow_indices":                stats.AutoFollowStats.NumberOfFailedFollowIndices,
+		"number_of_failed_remote_cluster_state_requests": stats.AutoFollowStats.NumberOfFailedRemoteClusterStateRequests,
+		"number_of_successful_follow_indices":            stats.AutoFollowStats.NumberOfSuccessfulFollowIndices,
+	}
+	if err := d.Set("auto_follow_stats", []interface{}{autoFollowStats}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	followStats := make([]interface{}, len(stats.FollowStats.Indices))
+	for i, index := range stats.FollowStats.Indices {
+		shards := make([]interface{}, len(index.Shards))
+		for j, shard := range index.Shards {
+			shards[j] = map[string]interface{}{
+				"shard_id":                    shard.ShardID,
+				"remote_cluster":              shard.RemoteCluster,
+				"leader_index":                shard.LeaderIndex,
+				"leader_global_checkpoint":    shard.LeaderGlobalCheckpoint,
+				"follower_global_checkpoint":  shard.FollowerGlobalCheckpoint,
+				"operations_read":             shard.OperationsRead,
+				"operations_written":          shard.OperationsWritten,
+				"failed_read_requests":        shard.FailedReadRequests,
+				"failed_write_requests":       shard.FailedWriteRequests,
+				"time_since_last_read_millis": shard.TimeSinceLastReadMillis,
+			}
+		}
+		followStats[i] = map[string]interface{}{
+			"index":  index.Index,
+			"shards": shards,
+		}
+	}
+	if err := d.Set("follow_stats", followStats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
@@ -0,0 +1,32 @@
+package ccr_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCcrStats(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCcrStats,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ccr_stats.test", "auto_follow_stats.#", "1"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_ccr_stats.test", "auto_follow_stats.0.number_of_successful_follow_indices"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceCcrStats = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ccr_stats" "test" {}
+`
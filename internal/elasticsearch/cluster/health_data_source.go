@@ -0,0 +1,235 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceClusterHealth() *schema.Resource {
+	healthSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"indices": {
+			Description: "Limits the health to the given data streams, indices and aliases. Wildcard (`*`) expressions are supported.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"wait_for_status": {
+			Description:  "Waits until the status of the cluster, or of the given indices, is the provided one or better, i.e. `green` > `yellow` > `red`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"green", "yellow", "red"}, false),
+		},
+		"wait_for_nodes": {
+			Description:  "Waits until the specified number of nodes is available. Accepts `>=N`, `<=N`, `>N`, `<N` or `N`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(>=|<=|>|<)?\d+$`), "must be a number of nodes, optionally prefixed with one of >=, <=, > or <"),
+		},
+		"wait_for_active_shards": {
+			Description:  "Waits until the specified number of shards is active. Use `all` to wait for all the shards in the cluster to be active.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(all|\d+)$`), "must be a number of shards or `all`"),
+		},
+		"wait_for_no_relocating_shards": {
+			Description: "If `true`, waits until there are no relocating shards in the cluster.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"wait_for_no_initializing_shards": {
+			Description: "If `true`, waits until there are no initializing shards in the cluster.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"timeout": {
+			Description: "Period to wait for the conditions to be met, e.g. `30s` or `5m`. An error is returned if the conditions are not met in time.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "30s",
+			ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+				if _, err := time.ParseDuration(i.(string)); err != nil {
+					return nil, []error{err}
+				}
+				return nil, nil
+			},
+		},
+		"cluster_name": {
+			Description: "The name of the cluster.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"status": {
+			Description: "Health status of the cluster, or of the given indices: `green`, `yellow` or `red`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"number_of_nodes": {
+			Description: "The number of nodes within the cluster.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"number_of_data_nodes": {
+			Description: "The number of nodes that are dedicated data nodes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"active_primary_shards": {
+			Description: "The number of active primary shards.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"active_shards": {
+			Description: "The total number of active primary and replica shards.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"relocating_shards": {
+			Description: "The number of shards that are under relocation.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"initializing_shards": {
+			Description: "The number of shards that are under initialization.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"unassigned_shards": {
+			Description: "The number of shards that are not allocated.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"delayed_unassigned_shards": {
+			Description: "The number of shards whose allocation has been delayed by the timeout settings.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"number_of_pending_tasks": {
+			Description: "The number of cluster-level changes that have not yet been executed.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"number_of_in_flight_fetch": {
+			Description: "The number of unfinished fetches.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"active_shards_percent": {
+			Description: "The ratio of active shards in the cluster expressed as a percentage.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(healthSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves the health of the cluster, optionally waiting for the given conditions to be met. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-health.html",
+
+		ReadContext: dataSourceClusterHealthRead,
+
+		Schema: healthSchema,
+	}
+}
+
+func dataSourceClusterHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	timeout, err := time.ParseDuration(d.Get("timeout").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params := models.ClusterHealthParams{
+		WaitForStatus:               d.Get("wait_for_status").(string),
+		WaitForNodes:                d.Get("wait_for_nodes").(string),
+		WaitForActiveShards:         d.Get("wait_for_active_shards").(string),
+		WaitForNoRelocatingShards:   d.Get("wait_for_no_relocating_shards").(bool),
+		WaitForNoInitializingShards: d.Get("wait_for_no_initializing_shards").(bool),
+		Timeout:                     timeout,
+	}
+	for _, i := range d.Get("indices").([]interface{}) {
+		params.Indices = append(params.Indices, i.(string))
+	}
+	id, diags := client.ID(ctx, fmt.Sprintf("health/%s", strings.Join(params.Indices, ",")))
+	if diags.HasError() {
+		return diags
+	}
+
+	health, diags := elasticsearch.GetClusterHealth(ctx, client, &params)
+	if diags.HasError() {
+		return diags
+	}
+	if health.TimedOut {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cluster health conditions not met",
+			Detail:   fmt.Sprintf(`The health conditions were not met within %s, the current status is "%s" with %d nodes, %d active and %d unassigned shards.`, timeout, health.Status, health.NumberOfNodes, health.ActiveShards, health.UnassignedShards),
+		})
+		return diags
+	}
+
+	if err := d.Set("cluster_name", health.ClusterName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", health.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("number_of_nodes", health.NumberOfNodes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("number_of_data_nodes", health.NumberOfDataNodes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("active_primary_shards", health.ActivePrimaryShards); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("active_shards", health.ActiveShards); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("relocating_shards", health.RelocatingShards); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("initializing_shards", health.InitializingShards); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("unassigned_shards", health.UnassignedShards); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("delayed_unassigned_shards", health.DelayedUnassignedShards); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("number_of_pending_tasks", health.NumberOfPendingTasks); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("number_of_in_flight_fetch", health.NumberOfInFlightFetch); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("active_shards_percent", health.ActiveShardsPercentAsNumber); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
@@ -0,0 +1,60 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceClusterHealth(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceClusterHealth(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_cluster_health.cluster", "cluster_name"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_cluster_health.cluster", "status"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_cluster_health.cluster", "number_of_nodes", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_cluster_health.index", "status", "green"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_cluster_health.index", "active_primary_shards", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_cluster_health.index", "active_shards", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_cluster_health.index", "unassigned_shards", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceClusterHealth(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name = "%s"
+
+  number_of_shards   = 2
+  number_of_replicas = 0
+}
+
+data "elasticstack_elasticsearch_cluster_health" "cluster" {
+  wait_for_nodes = ">=1"
+}
+
+data "elasticstack_elasticsearch_cluster_health" "index" {
+  indices = [elasticstack_elasticsearch_index.test.name]
+
+  wait_for_status        = "green"
+  wait_for_active_shards = "all"
+  timeout                = "1m"
+}
+	`, name)
+}
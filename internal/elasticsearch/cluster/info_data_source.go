@@ -0,0 +1,155 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceInfo() *schema.Resource {
+	infoSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the node which served the request.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"cluster_name": {
+			Description: "The name of the cluster.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"cluster_uuid": {
+			Description: "The unique identifier of the cluster.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"version": {
+			Description: "The Elasticsearch version of the node which served the request.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"build_flavor": {
+			Description: "The build flavor, e.g. `default` or `serverless`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"build_type": {
+			Description: "The build type, e.g. `docker` or `tar`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"build_hash": {
+			Description: "The hash of the commit the build was made from.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"build_date": {
+			Description: "The date of the build, in RFC3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"build_snapshot": {
+			Description: "Whether the build is a snapshot build.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"lucene_version": {
+			Description: "The Lucene version used by Elasticsearch.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"minimum_wire_compatibility_version": {
+			Description: "The minimum node version the node can communicate with.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"minimum_index_compatibility_version": {
+			Description: "The minimum index version the node can read.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"tagline": {
+			Description: "The tagline of the cluster.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(infoSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves basic information about the cluster, such as its name, UUID and version. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/rest-api-root.html",
+
+		ReadContext: dataSourceInfoRead,
+
+		Schema: infoSchema,
+	}
+}
+
+func dataSourceInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	info, diags := client.ServerInfo(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	id, diags := client.ID(ctx, "info")
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("name", info.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cluster_name", info.ClusterName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cluster_uuid", info.ClusterUUID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("version", info.Version.Number); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("build_flavor", info.Version.BuildFlavor); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("build_type", info.Version.BuildType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("build_hash", info.Version.BuildHash); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("build_date", info.Version.BuildDate.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("build_snapshot", info.Version.BuildSnapshot); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("lucene_version", info.Version.LuceneVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("minimum_wire_compatibility_version", info.Version.MinimumWireCompatibilityVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("minimum_index_compatibility_version", info.Version.MinimumIndexCompatibilityVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tagline", info.Tagline); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
@@ -0,0 +1,36 @@
+package cluster_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceInfo(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceInfo,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_info.test", "cluster_name"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_info.test", "cluster_uuid"),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_info.test", "version", regexp.MustCompile(`^\d+\.\d+\.\d+`)),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_info.test", "build_flavor", "default"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_info.test", "lucene_version"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceInfo = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_info" "test" {}
+`
@@ -0,0 +1,233 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceNodes() *schema.Resource {
+	pluginSchema := map[string]*schema.Schema{
+		"name": {
+			Description: "The name of the plugin or module.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"version": {
+			Description: "The version of the plugin or module.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"elasticsearch_version": {
+			Description: "The Elasticsearch version the plugin or module was built for.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: "The description of the plugin or module.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"classname": {
+			Description: "The entry point class of the plugin or module.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	nodesSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"node_ids": {
+			Description: "Limits the returned nodes to the given node IDs, names or node filters, e.g. `_master`, `data:true` or `attr_name:value`. All the nodes are returned by default.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"cluster_name": {
+			Description: "The name of the cluster.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"nodes": {
+			Description: "The nodes of the cluster, ordered by their ID.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The unique identifier of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"host": {
+						Description: "The host name of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"ip": {
+						Description: "The IP address of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"transport_address": {
+						Description: "The host and port where the node accepts transport connections.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"version": {
+						Description: "The Elasticsearch version running on the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"build_flavor": {
+						Description: "The build flavor of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"build_type": {
+						Description: "The build type of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"build_hash": {
+						Description: "The hash of the commit the node was built from.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"roles": {
+						Description: "The roles assigned to the node.",
+						Type:        schema.TypeSet,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"attributes": {
+						Description: "The custom attributes of the node.",
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"plugins": {
+						Description: "The plugins installed on the node.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: pluginSchema,
+						},
+					},
+					"modules": {
+						Description: "The modules loaded on the node.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: pluginSchema,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(nodesSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves information about the nodes of the cluster, including their roles, attributes, versions, plugins and modules. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-nodes-info.html",
+
+		ReadContext: dataSourceNodesRead,
+
+		Schema: nodesSchema,
+	}
+}
+
+func dataSourceNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	nodeIds := make([]string, 0)
+	for _, n := range d.Get("node_ids").([]interface{}) {
+		nodeIds = append(nodeIds, n.(string))
+	}
+	id, diags := client.ID(ctx, fmt.Sprintf("nodes/%s", strings.Join(nodeIds, ",")))
+	if diags.HasError() {
+		return diags
+	}
+
+	info, diags := elasticsearch.GetNodesInfo(ctx, client, nodeIds)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("cluster_name", info.ClusterName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// keep a stable order of the nodes between reads
+	ids := make([]string, 0, len(info.Nodes))
+	for nodeId := range info.Nodes {
+		ids = append(ids, nodeId)
+	}
+	sort.Strings(ids)
+	nodes := make([]interface{}, 0, len(ids))
+	for _, nodeId := range ids {
+		node := info.Nodes[nodeId]
+		nodes = append(nodes, map[string]interface{}{
+			"id":                nodeId,
+			"name":              node.Name,
+			"host":              node.Host,
+			"ip":                node.Ip,
+			"transport_address": node.TransportAddress,
+			"version":           node.Version,
+			"build_flavor":      node.BuildFlavor,
+			"build_type":        node.BuildType,
+			"build_hash":        node.BuildHash,
+			"roles":             node.Roles,
+			"attributes":        node.Attributes,
+			"plugins":           flattenNodePlugins(node.Plugins),
+			"modules":           flattenNodePlugins(node.Modules),
+		})
+	}
+	if err := d.Set("nodes", nodes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
+
+func flattenNodePlugins(plugins []models.NodePlugin) []interface{} {
+	result := make([]interface{}, len(plugins))
+	for i, p := range plugins {
+		result[i] = map[string]interface{}{
+			"name":                  p.Name,
+			"version":               p.Version,
+			"elasticsearch_version": p.ElasticsearchVersion,
+			"description":           p.Description,
+			"classname":             p.Classname,
+		}
+	}
+	return result
+}
@@ -0,0 +1,41 @@
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNodes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNodes,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_nodes.all", "cluster_name"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_nodes.all", "nodes.#", "1"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_nodes.all", "nodes.0.name"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_nodes.all", "nodes.0.version"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_nodes.all", "nodes.0.roles.*", "master"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_nodes.all", "nodes.0.modules.0.name"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_nodes.master", "nodes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceNodes = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_nodes" "all" {}
+
+data "elasticstack_elasticsearch_nodes" "master" {
+  node_ids = ["_master"]
+}
+`
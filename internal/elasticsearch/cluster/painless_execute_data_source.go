@@ -0,0 +1,142 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourcePainlessExecute() *schema.Resource {
+	executeSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"source": {
+			Description: "The painless script to execute.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"params": {
+			Description:  "Parameters passed to the script.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+		"context": {
+			Description: "The context the script runs in, e.g. `painless_test`, `filter` or `score`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "painless_test",
+		},
+		"index": {
+			Description: "The index whose mappings are used to parse the `document`. Required by the contexts other than `painless_test`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"document": {
+			Description:  "Document made available to the script, e.g. through `doc` in the `filter` and `score` contexts.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+		"query": {
+			Description:  "Query used by the `score` context to compute the `_score` of the document.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+		"result": {
+			Description: "The result of the script. Results which are not strings, e.g. booleans or numbers, are JSON encoded.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(executeSchema)
+
+	return &schema.Resource{
+		Description: "Executes a painless script and returns its result. See, https://www.elastic.co/guide/en/elasticsearch/painless/current/painless-execute-api.html",
+
+		ReadContext: dataSourcePainlessExecuteRead,
+
+		Schema: executeSchema,
+	}
+}
+
+func dataSourcePainlessExecuteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	execute := models.PainlessExecute{
+		Script: models.PainlessScript{
+			Source: d.Get("source").(string),
+		},
+		Context: d.Get("context").(string),
+	}
+	if v, ok := d.GetOk("params"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &execute.Script.Params); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	index := d.Get("index").(string)
+	document, hasDocument := d.GetOk("document")
+	query, hasQuery := d.GetOk("query")
+	if index != "" || hasDocument || hasQuery {
+		execute.ContextSetup = &models.PainlessContextSetup{Index: index}
+		if hasDocument {
+			if err := json.Unmarshal([]byte(document.(string)), &execute.ContextSetup.Document); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if hasQuery {
+			if err := json.Unmarshal([]byte(query.(string)), &execute.ContextSetup.Query); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	executeBytes, err := json.Marshal(execute)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hash, err := utils.StringToHash(string(executeBytes))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id, diags := client.ID(ctx, fmt.Sprintf("painless-execute/%s", *hash))
+	if diags.HasError() {
+		return diags
+	}
+
+	result, diags := elasticsearch.ExecutePainlessScript(ctx, client, &execute)
+	if diags.HasError() {
+		return diags
+	}
+
+	value, ok := result.Result.(string)
+	if !ok {
+		resultBytes, err := json.Marshal(result.Result)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		value = string(resultBytes)
+	}
+	if err := d.Set("result", value); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
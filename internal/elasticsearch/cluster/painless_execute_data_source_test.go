@@ -0,0 +1,77 @@
+package cluster_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePainlessExecute(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePainlessExecute(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_painless_execute.test", "result", "50.0"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_painless_execute.filter", "result", "true"),
+				),
+			},
+			{
+				Config:      testAccDataSourcePainlessExecuteInvalid,
+				ExpectError: regexp.MustCompile(`Line 2, column \d+`),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePainlessExecute(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name = "%s"
+
+  mappings = jsonencode({
+    properties = {
+      level = { type = "keyword" }
+    }
+  })
+}
+
+data "elasticstack_elasticsearch_painless_execute" "test" {
+  source = "params.count / 2.0"
+  params = jsonencode({ count = 100 })
+}
+
+data "elasticstack_elasticsearch_painless_execute" "filter" {
+  source   = "doc['level'].value == 'error'"
+  context  = "filter"
+  index    = elasticstack_elasticsearch_index.test.name
+  document = jsonencode({ level = "error" })
+}
+	`, name)
+}
+
+const testAccDataSourcePainlessExecuteInvalid = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_painless_execute" "test" {
+  source = <<EOF
+int total = params.count;
+return totl / 2;
+EOF
+  params = jsonencode({ count = 100 })
+}
+`
@@ -0,0 +1,359 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The remote cluster settings managed by the resource, keyed by the attribute name
+var remoteClusterSettings = map[string]string{
+	"mode":                     "mode",
+	"seeds":                    "seeds",
+	"node_connections":         "node_connections",
+	"proxy_address":            "proxy_address",
+	"proxy_socket_connections": "proxy_socket_connections",
+	"server_name":              "server_name",
+	"skip_unavailable":         "skip_unavailable",
+	"compress":                 "transport.compress",
+	"compression_scheme":       "transport.compression_scheme",
+	"ping_schedule":            "transport.ping_schedule",
+}
+
+func ResourceRemoteCluster() *schema.Resource {
+	remoteClusterSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The alias of the remote cluster.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"mode": {
+			Description:  "The mode used to connect to the remote cluster, either `sniff` or `proxy`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "sniff",
+			ValidateFunc: validation.StringInSlice([]string{"sniff", "proxy"}, false),
+		},
+		"seeds": {
+			Description: "The list of seed nodes used to sniff the remote cluster state. Required in `sniff` mode.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"node_connections": {
+			Description:  "The number of gateway nodes to connect to in `sniff` mode.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"proxy_address": {
+			Description: "The address used for all remote connections in `proxy` mode.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"proxy_socket_connections": {
+			Description:  "The number of socket connections to open to the remote cluster in `proxy` mode.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"server_name": {
+			Description: "An optional hostname string which is sent in the server_name field of the TLS Server Name Indication extension in `proxy` mode.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"skip_unavailable": {
+			Description: "Whether to skip the cluster during searches when it is unavailable.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"compress": {
+			Description:  "Whether to compress the requests sent to the remote cluster, one of `true`, `false` or `indexing_data`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"true", "false", "indexing_data"}, false),
+		},
+		"compression_scheme": {
+			Description:  "The compression scheme used when compressing requests to the remote cluster, either `deflate` or `lz4`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"deflate", "lz4"}, false),
+		},
+		"ping_schedule": {
+			Description: "The interval at which to send application-level pings to the remote cluster, e.g. `30s`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"wait_for_connection": {
+			Description: "Whether to wait until the remote cluster is connected when creating or updating the resource. Uses the create and update timeouts.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"connected": {
+			Description: "Whether the local cluster is connected to the remote cluster.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"num_nodes_connected": {
+			Description: "The number of connected nodes in the remote cluster, in `sniff` mode.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"num_proxy_sockets_connected": {
+			Description: "The number of open socket connections to the remote cluster, in `proxy` mode.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(remoteClusterSchema)
+
+	return &schema.Resource{
+		Description: "Configures a remote cluster for cross-cluster search and replication. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/remote-clusters-settings.html",
+
+		CreateContext: resourceRemoteClusterPut,
+		UpdateContext: resourceRemoteClusterPut,
+		ReadContext:   resourceRemoteClusterRead,
+		DeleteContext: resourceRemoteClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: remoteClusterSchema,
+	}
+}
+
+func remoteClusterSettingKey(name, setting string) string {
+	return fmt.Sprintf("cluster.remote.%s.%s", name, setting)
+}
+
+func resourceRemoteClusterPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	name := d.Get("name").(string)
+	id, diags := client.ID(ctx, name)
+	if diags.HasError() {
+		return diags
+	}
+
+	settings, diags := expandRemoteClusterSettings(d)
+	if diags.HasError() {
+		return diags
+	}
+	if diags := elasticsearch.PutSettings(ctx, client, map[string]interface{}{"persistent": settings}); diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if d.Get("wait_for_connection").(bool) {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+		if diags := waitForRemoteClusterConnection(ctx, client, name, timeout); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceRemoteClusterRead(ctx, d, meta)
+}
+
+// expandRemoteClusterSettings returns all the managed settings of the remote cluster, with the ones which are not configured set to null
+func expandRemoteClusterSettings(d *schema.ResourceData) (map[string]interface{}, diag.Diagnostics) {
+	name := d.Get("name").(string)
+	mode := d.Get("mode").(string)
+
+	seeds := d.Get("seeds").([]interface{})
+	proxyAddress := d.Get("proxy_address").(string)
+	if mode == "sniff" && len(seeds) == 0 {
+		return nil, diag.Errorf(`"seeds" must be set when the remote cluster "%s" uses the sniff mode`, name)
+	}
+	if mode == "proxy" && proxyAddress == "" {
+		return nil, diag.Errorf(`"proxy_address" must be set when the remote cluster "%s" uses the proxy mode`, name)
+	}
+
+	settings := make(map[string]interface{}, len(remoteClusterSettings))
+	for _, setting := range remoteClusterSettings {
+		settings[remoteClusterSettingKey(name, setting)] = nil
+	}
+
+	settings[remoteClusterSettingKey(name, "mode")] = mode
+	settings[remoteClusterSettingKey(name, "skip_unavailable")] = d.Get("skip_unavailable").(bool)
+	switch mode {
+	case "sniff":
+		settings[remoteClusterSettingKey(name, "seeds")] = seeds
+		if v, ok := d.GetOk("node_connections"); ok {
+			settings[remoteClusterSettingKey(name, "node_connections")] = v.(int)
+		}
+	case "proxy":
+		settings[remoteClusterSettingKey(name, "proxy_address")] = proxyAddress
+		if v, ok := d.GetOk("proxy_socket_connections"); ok {
+			settings[remoteClusterSettingKey(name, "proxy_socket_connections")] = v.(int)
+		}
+		if v, ok := d.GetOk("server_name"); ok {
+			settings[remoteClusterSettingKey(name, "server_name")] = v.(string)
+		}
+	}
+	for _, attr := range []string{"compress", "compression_scheme", "ping_schedule"} {
+		if v, ok := d.GetOk(attr); ok {
+			settings[remoteClusterSettingKey(name, remoteClusterSettings[attr])] = v.(string)
+		}
+	}
+
+	return settings, nil
+}
+
+func waitForRemoteClusterConnection(ctx context.Context, client *clients.ApiClient, name string, timeout time.Duration) diag.Diagnostics {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		remoteInfo, diags := elasticsearch.GetRemoteInfo(ctx, client)
+		if diags.HasError() {
+			return resource.NonRetryableError(fmt.Errorf("%v", diags))
+		}
+		if info, ok := remoteInfo[name]; !ok || !info.Connected {
+			return resource.RetryableError(fmt.Errorf(`remote cluster "%s" is not connected yet`, name))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceRemoteClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	name := compId.ResourceId
+
+	clusterSettings, diags := elasticsearch.GetSettings(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	persistent, _ := clusterSettings["persistent"].(map[string]interface{})
+	prefix := fmt.Sprintf("cluster.remote.%s.", name)
+	settings := make(map[string]interface{})
+	for k, v := range persistent {
+		if strings.HasPrefix(k, prefix) {
+			settings[strings.TrimPrefix(k, prefix)] = v
+		}
+	}
+	if len(settings) == 0 {
+		tflog.Warn(ctx, fmt.Sprintf(`Remote cluster "%s" not found, removing from state`, name))
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("name", name); err != nil {
+		return diag.FromErr(err)
+	}
+	mode := "sniff"
+	if v, ok := settings["mode"].(string); ok {
+		mode = v
+	}
+	if err := d.Set("mode", mode); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("seeds", settings["seeds"]); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, attr := range []string{"proxy_address", "server_name", "compress", "compression_scheme", "ping_schedule"} {
+		v, _ := settings[remoteClusterSettings[attr]].(string)
+		if err := d.Set(attr, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	for _, attr := range []string{"node_connections", "proxy_socket_connections"} {
+		i := 0
+		if v, ok := settings[remoteClusterSettings[attr]].(string); ok {
+			var err error
+			if i, err = strconv.Atoi(v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err := d.Set(attr, i); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	skipUnavailable := false
+	if v, ok := settings["skip_unavailable"].(string); ok {
+		var err error
+		if skipUnavailable, err = strconv.ParseBool(v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("skip_unavailable", skipUnavailable); err != nil {
+		return diag.FromErr(err)
+	}
+
+	remoteInfo, diags := elasticsearch.GetRemoteInfo(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	info := remoteInfo[name]
+	if err := d.Set("connected", info.Connected); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("num_nodes_connected", info.NumNodesConnected); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("num_proxy_sockets_connected", info.NumProxySocketsConnected); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceRemoteClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	settings := make(map[string]interface{}, len(remoteClusterSettings))
+	for _, setting := range remoteClusterSettings {
+		settings[remoteClusterSettingKey(compId.ResourceId, setting)] = nil
+	}
+	if diags := elasticsearch.PutSettings(ctx, client, map[string]interface{}{"persistent": settings}); diags.HasError() {
+		return diags
+	}
+	return diags
+}
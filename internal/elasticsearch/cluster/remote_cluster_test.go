@@ -0,0 +1,107 @@
+package cluster_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceRemoteCluster(t *testing.T) {
+	// generate a random name
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceRemoteClusterDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRemoteClusterSniff(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "name", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "mode", "sniff"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "seeds.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "seeds.0", "127.0.0.1:9300"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "skip_unavailable", "true"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_remote_cluster.test", "connected"),
+				),
+			},
+			{
+				Config: testAccResourceRemoteClusterProxy(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "name", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "mode", "proxy"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "seeds.#", "0"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "proxy_address", "127.0.0.1:9300"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "proxy_socket_connections", "6"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "skip_unavailable", "false"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_remote_cluster.test", "compress", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceRemoteClusterSniff(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_remote_cluster" "test" {
+  name             = "%s"
+  seeds            = ["127.0.0.1:9300"]
+  skip_unavailable = true
+}
+	`, name)
+}
+
+func testAccResourceRemoteClusterProxy(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_remote_cluster" "test" {
+  name                     = "%s"
+  mode                     = "proxy"
+  proxy_address            = "127.0.0.1:9300"
+  proxy_socket_connections = 6
+  compress                 = "true"
+}
+	`, name)
+}
+
+func checkResourceRemoteClusterDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_remote_cluster" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+
+		clusterSettings, diags := elasticsearch.GetSettings(context.Background(), client)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get cluster settings %v", diags)
+		}
+		persistent, _ := clusterSettings["persistent"].(map[string]interface{})
+		for k := range persistent {
+			if strings.HasPrefix(k, fmt.Sprintf("cluster.remote.%s.", compId.ResourceId)) {
+				return fmt.Errorf(`Setting "%s" still in the cluster, but it should be removed`, k)
+			}
+		}
+	}
+	return nil
+}
@@ -46,6 +46,40 @@ func ResourceScript() *schema.Resource {
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
+		"validation": {
+			Description: "Validates the painless script during the plan by running it with the painless execute API. Compilation and runtime errors are reported along with their line and column.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"context": {
+						Description: "The context to run the script in, e.g. `painless_test`, `filter` or `score`. Defaults to the `context` of the script when the painless execute API supports it, i.e. `painless_test`, or `filter` and `score` along with `index`, and to `painless_test` for scripts without a context. Scripts in other contexts are only validated when it is set.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"params": {
+						Description:      "Sample parameters to run the script with, instead of the `params` of the script.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+						ValidateFunc:     validation.StringIsJSON,
+					},
+					"index": {
+						Description: "The index whose mappings are used to parse the sample `document`. Required by the `filter` and `score` contexts.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"document": {
+						Description:      "Sample document to run the script against, used by the `filter` and `score` contexts.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+						ValidateFunc:     validation.StringIsJSON,
+					},
+				},
+			},
+		},
 	}
 	utils.AddConnectionSchema(scriptSchema)
 
@@ -61,6 +95,8 @@ func ResourceScript() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceScriptCustomizeDiff,
+
 		Schema: scriptSchema,
 	}
 }
@@ -148,3 +184,82 @@ func resourceScriptDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	return elasticsearch.DeleteScript(ctx, client, compId.ResourceId)
 }
+
+// resourceScriptCustomizeDiff runs the painless script when validation is requested, so errors are reported before applying
+func resourceScriptCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("validation")
+	if !ok || !d.HasChanges("lang", "source", "params", "context", "validation") {
+		return nil
+	}
+	if !d.NewValueKnown("source") || !d.NewValueKnown("params") || !d.NewValueKnown("validation") {
+		return nil
+	}
+	if lang := d.Get("lang").(string); lang != "painless" {
+		return fmt.Errorf(`validation is only supported for painless scripts, got "%s"`, lang)
+	}
+
+	// an empty validation block is read as a nil element
+	settings, ok := v.([]interface{})[0].(map[string]interface{})
+	if !ok {
+		settings = make(map[string]interface{})
+	}
+	validationContext, _ := settings["context"].(string)
+	validationParams, _ := settings["params"].(string)
+	index, _ := settings["index"].(string)
+	document, _ := settings["document"].(string)
+
+	execute := models.PainlessExecute{
+		Context: painlessExecuteContext(validationContext, d.Get("context").(string), index),
+		Script: models.PainlessScript{
+			Source: d.Get("source").(string),
+		},
+	}
+	if execute.Context == "" {
+		// the script can't be run in its context, and would fail to compile in another one
+		return nil
+	}
+	params := d.Get("params").(string)
+	if validationParams != "" {
+		params = validationParams
+	}
+	if params != "" {
+		if err := json.Unmarshal([]byte(params), &execute.Script.Params); err != nil {
+			return err
+		}
+	}
+	if index != "" || document != "" {
+		execute.ContextSetup = &models.PainlessContextSetup{Index: index}
+		if document != "" {
+			if err := json.Unmarshal([]byte(document), &execute.ContextSetup.Document); err != nil {
+				return err
+			}
+		}
+	}
+
+	client, diags := clients.NewApiClientFromDiff(d, meta)
+	if diags.HasError() {
+		return utils.DiagsAsError(diags)
+	}
+	if _, diags := elasticsearch.ExecutePainlessScript(ctx, client, &execute); diags.HasError() {
+		return utils.DiagsAsError(diags)
+	}
+	return nil
+}
+
+// painlessExecuteContext returns the context to validate the script in: the configured one, or the context of the script
+// when the painless execute API supports it. `filter` and `score` also need an index to run against. Scripts without a
+// context are validated in `painless_test`, the others aren't validated when their context isn't supported.
+func painlessExecuteContext(validationContext, scriptContext, index string) string {
+	if validationContext != "" {
+		return validationContext
+	}
+	switch scriptContext {
+	case "", "painless_test":
+		return "painless_test"
+	case "filter", "score":
+		if index != "" {
+			return scriptContext
+		}
+	}
+	return ""
+}
@@ -2,6 +2,7 @@ package cluster_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
@@ -41,6 +42,108 @@ func TestAccResourceScript(t *testing.T) {
 	})
 }
 
+func TestAccResourceScriptValidation(t *testing.T) {
+	scriptID := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkScriptDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptValidation(scriptID, "params['my_modifier'] * unknown_var"),
+				ExpectError: regexp.MustCompile(`Line 1, column \d+: .*unknown_var`),
+			},
+			{
+				Config: testAccScriptValidation(scriptID, "params['my_modifier'] * 2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_script.test", "script_id", scriptID),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_script.test", "validation.0.context", "painless_test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScriptValidation(id, source string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_script" "test" {
+  script_id = "%s"
+  lang      = "painless"
+  source    = "%s"
+
+  validation {
+    context = "painless_test"
+    params  = jsonencode({ my_modifier = 2 })
+  }
+}
+	`, id, source)
+}
+
+func TestAccResourceScriptValidationUnsupportedContext(t *testing.T) {
+	scriptID := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkScriptDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				// the painless execute API doesn't support the aggs context, where doc is defined, the script isn't validated
+				Config: testAccScriptValidationContext(scriptID, "aggs"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_script.test", "script_id", scriptID),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_script.test", "context", "aggs"),
+				),
+			},
+			{
+				// an empty validation block validates the script without context in painless_test
+				Config: testAccScriptEmptyValidation(scriptID),
+				Check:  resource.TestCheckResourceAttr("elasticstack_elasticsearch_script.test", "validation.#", "1"),
+			},
+		},
+	})
+}
+
+func testAccScriptEmptyValidation(id string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_script" "test" {
+  script_id = "%s"
+  lang      = "painless"
+  source    = "2 * 2"
+
+  validation {}
+}
+	`, id)
+}
+
+func testAccScriptValidationContext(id, context string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_script" "test" {
+  script_id = "%s"
+  lang      = "painless"
+  source    = "doc['price'].value * params['my_modifier']"
+  context   = "%s"
+
+  validation {
+    params = jsonencode({ my_modifier = 2 })
+  }
+}
+	`, id, context)
+}
+
 func TestAccResourceScriptSearchTemplate(t *testing.T) {
 	scriptID := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
 
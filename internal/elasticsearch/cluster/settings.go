@@ -3,10 +3,13 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -65,6 +68,20 @@ func ResourceSettings() *schema.Resource {
 			Optional:    true,
 			Elem:        settingSchema,
 		},
+		"authoritative": {
+			Description: "If `true`, the resource manages all the persistent settings of the cluster: the persistent settings missing from the configuration, including the ones changed outside of Terraform, are reported as drift and removed on apply. The `cluster.remote.*` and `slm.retention_*` settings, managed by `elasticstack_elasticsearch_remote_cluster` and `elasticstack_elasticsearch_snapshot_lifecycle_settings`, are left alone unless they are configured.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"effective_settings": {
+			Description: "The values in effect for the settings tracked by the resource. Transient settings take precedence over persistent ones, and the values of lists are joined with commas.",
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
 	}
 
 	utils.AddConnectionSchema(settingsSchema)
@@ -78,9 +95,11 @@ func ResourceSettings() *schema.Resource {
 		DeleteContext: resourceClusterSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceClusterSettingsImport,
 		},
 
+		CustomizeDiff: resourceClusterSettingsCustomizeDiff,
+
 		Schema: settingsSchema,
 	}
 }
@@ -108,6 +127,23 @@ func resourceClusterSettingsPut(ctx context.Context, d *schema.ResourceData, met
 			}
 		}
 	}
+	if d.Get("authoritative").(bool) {
+		clusterSettings, diags := elasticsearch.GetSettings(ctx, client)
+		if diags.HasError() {
+			return diags
+		}
+		// remove the persistent settings which are not in the configuration, even the ones we have never seen
+		persistent, ok := settings["persistent"].(map[string]interface{})
+		if !ok {
+			persistent = make(map[string]interface{})
+			settings["persistent"] = persistent
+		}
+		for k := range authoritativeSettings(clusterSettings, persistent) {
+			if _, ok := persistent[k]; !ok {
+				persistent[k] = nil
+			}
+		}
+	}
 	if diags := elasticsearch.PutSettings(ctx, client, settings); diags.HasError() {
 		return diags
 	}
@@ -213,6 +249,11 @@ func resourceClusterSettingsRead(ctx context.Context, d *schema.ResourceData, me
 		return diags
 	}
 	configuredSettings, _ := getConfiguredSettings(d)
+	if d.Get("authoritative").(bool) {
+		// track every persistent setting of the cluster, so the ones added outside of Terraform show up as drift
+		configured, _ := configuredSettings["persistent"].(map[string]interface{})
+		configuredSettings["persistent"] = authoritativeSettings(clusterSettings, configured)
+	}
 	persistent := flattenSettings("persistent", configuredSettings, clusterSettings)
 	transient := flattenSettings("transient", configuredSettings, clusterSettings)
 
@@ -222,9 +263,83 @@ func resourceClusterSettingsRead(ctx context.Context, d *schema.ResourceData, me
 	if err := d.Set("transient", transient); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("effective_settings", effectiveSettings(configuredSettings, clusterSettings)); err != nil {
+		return diag.FromErr(err)
+	}
 	return diags
 }
 
+// settingsOf returns the settings of the given type, i.e. persistent or transient, from the flat cluster settings
+func settingsOf(clusterSettings map[string]interface{}, name string) map[string]interface{} {
+	if settings, ok := clusterSettings[name].(map[string]interface{}); ok {
+		return settings
+	}
+	return make(map[string]interface{})
+}
+
+// prefixes of the persistent settings managed by other resources, e.g. `elasticstack_elasticsearch_remote_cluster`
+// and `elasticstack_elasticsearch_snapshot_lifecycle_settings`, which an authoritative resource leaves alone
+var authoritativeExcludedPrefixes = []string{
+	"cluster.remote.",
+	"slm.retention_",
+}
+
+// authoritativeSettings returns the persistent settings of the cluster managed by an authoritative resource,
+// the ones under the excluded prefixes are only managed when they are configured
+func authoritativeSettings(clusterSettings map[string]interface{}, configured map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range settingsOf(clusterSettings, "persistent") {
+		if _, ok := configured[k]; !ok && isAuthoritativeExcluded(k) {
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+func isAuthoritativeExcluded(name string) bool {
+	for _, prefix := range authoritativeExcludedPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// effectiveSettings returns the values in effect for the tracked settings, a transient setting overrides the persistent one
+func effectiveSettings(tracked, clusterSettings map[string]interface{}) map[string]interface{} {
+	persistent := settingsOf(clusterSettings, "persistent")
+	transient := settingsOf(clusterSettings, "transient")
+
+	result := make(map[string]interface{})
+	for _, name := range []string{"persistent", "transient"} {
+		trackedSettings, ok := tracked[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for k := range trackedSettings {
+			v, ok := transient[k]
+			if !ok {
+				v, ok = persistent[k]
+			}
+			if !ok {
+				continue
+			}
+			switch t := v.(type) {
+			case []interface{}:
+				values := make([]string, len(t))
+				for i, e := range t {
+					values[i] = fmt.Sprintf("%v", e)
+				}
+				result[k] = strings.Join(values, ",")
+			default:
+				result[k] = fmt.Sprintf("%v", t)
+			}
+		}
+	}
+	return result
+}
+
 func flattenSettings(name string, old, new map[string]interface{}) []interface{} {
 	setting := make(map[string]interface{})
 	settings := make([]interface{}, 0)
@@ -287,3 +402,96 @@ func resourceClusterSettingsDelete(ctx context.Context, d *schema.ResourceData,
 
 	return diags
 }
+
+// populates the state with all the persistent and transient settings currently set in the cluster
+func resourceClusterSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return nil, utils.DiagsAsError(diags)
+	}
+	clusterSettings, diags := elasticsearch.GetSettings(ctx, client)
+	if diags.HasError() {
+		return nil, utils.DiagsAsError(diags)
+	}
+
+	for _, name := range []string{"persistent", "transient"} {
+		if err := d.Set(name, flattenSettings(name, clusterSettings, clusterSettings)); err != nil {
+			return nil, err
+		}
+	}
+	if err := d.Set("authoritative", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceClusterSettingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("persistent", "transient") {
+		return nil
+	}
+	if err := d.SetNewComputed("effective_settings"); err != nil {
+		return err
+	}
+
+	names := make([]string, 0)
+	for _, v := range []string{"persistent", "transient"} {
+		for _, s := range d.Get(v).([]interface{}) {
+			if s == nil {
+				continue
+			}
+			for _, setting := range s.(map[string]interface{})["setting"].(*schema.Set).List() {
+				// the name is empty while it is not known yet
+				if name := setting.(map[string]interface{})["name"].(string); name != "" {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	client, diags := clients.NewApiClientFromDiff(d, meta)
+	if diags.HasError() {
+		return utils.DiagsAsError(diags)
+	}
+	clusterSettings, diags := elasticsearch.GetSettingsWithDefaults(ctx, client)
+	if diags.HasError() {
+		// the cluster might not be reachable yet during the plan, e.g. when it's created in the same run
+		tflog.Warn(ctx, fmt.Sprintf("Skipping the validation of the cluster settings names: %s", utils.DiagsAsError(diags)))
+		return nil
+	}
+
+	if misspelt := MisspeltSettings(names, clusterSettings); len(misspelt) > 0 {
+		return fmt.Errorf(`unknown cluster settings: "%s". Check the names against the settings returned by GET _cluster/settings?include_defaults`, strings.Join(misspelt, `", "`))
+	}
+	return nil
+}
+
+// MisspeltSettings returns the names which match no setting of the cluster, but share their namespace with known settings,
+// e.g. `indices.lifecycle.poll_intervall`. The other names, e.g. the affix settings like `cluster.remote.<alias>.seeds` which
+// are not returned along with the defaults, are left to the validation of the cluster when the settings are applied.
+func MisspeltSettings(names []string, clusterSettings map[string]interface{}) []string {
+	known := make(map[string]bool)
+	namespaces := make(map[string]bool)
+	for _, t := range []string{"defaults", "persistent", "transient"} {
+		for name := range settingsOf(clusterSettings, t) {
+			known[name] = true
+			namespaces[settingNamespace(name)] = true
+		}
+	}
+
+	misspelt := make([]string, 0)
+	for _, name := range names {
+		if !known[name] && namespaces[settingNamespace(name)] {
+			misspelt = append(misspelt, name)
+		}
+	}
+	sort.Strings(misspelt)
+	return misspelt
+}
+
+// settingNamespace returns the name of the setting up to its last dot
+func settingNamespace(name string) string {
+	return name[:strings.LastIndex(name, ".")+1]
+}
@@ -1,12 +1,16 @@
 package cluster_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/cluster"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -69,6 +73,199 @@ func TestAccResourceClusterSettings(t *testing.T) {
 	})
 }
 
+func TestMisspeltSettings(t *testing.T) {
+	t.Parallel()
+
+	clusterSettings := map[string]interface{}{
+		"defaults": map[string]interface{}{
+			"indices.lifecycle.poll_interval":     "10m",
+			"xpack.monitoring.collection.enabled": "false",
+		},
+		"persistent": map[string]interface{}{
+			"indices.recovery.max_bytes_per_sec": "50mb",
+		},
+		"transient": map[string]interface{}{},
+	}
+
+	tests := []struct {
+		name  string
+		names []string
+		want  []string
+	}{
+		{
+			name:  "accepts the known settings",
+			names: []string{"indices.lifecycle.poll_interval", "indices.recovery.max_bytes_per_sec"},
+			want:  []string{},
+		},
+		{
+			name:  "rejects the unknown settings of a known namespace",
+			names: []string{"indices.recovery.max_bytes_per_second", "indices.lifecycle.poll_intervall"},
+			want:  []string{"indices.lifecycle.poll_intervall", "indices.recovery.max_bytes_per_second"},
+		},
+		{
+			name:  "leaves the affix settings to the cluster",
+			names: []string{"xpack.monitoring.exporters.my_remote.type", "cluster.remote.my_cluster.seeds", "logger.org.elasticsearch.transport"},
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := cluster.MisspeltSettings(tt.names, clusterSettings); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MisspeltSettings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccResourceClusterSettingsAuthoritative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceClusterSettingsDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceClusterSettingsUnknown,
+				ExpectError: regexp.MustCompile(`unknown cluster settings: "indices.lifecycle.poll_intervall"`),
+			},
+			{
+				Config: testAccResourceClusterSettingsAuthoritative,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_cluster_settings.test", "authoritative", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_cluster_settings.test", "persistent.0.setting.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_cluster_settings.test", "effective_settings.indices.lifecycle.poll_interval", "5m"),
+				),
+			},
+			{
+				// settings changed by hand are reverted
+				PreConfig: func() {
+					putClusterSettings(t, `{"persistent": {"indices.recovery.max_concurrent_file_chunks": "3"}}`)
+				},
+				Config: testAccResourceClusterSettingsAuthoritative,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_cluster_settings.test", "persistent.0.setting.#", "1"),
+					checkClusterSettingAbsent("indices.recovery.max_concurrent_file_chunks"),
+				),
+			},
+			{
+				// settings managed by other resources are left alone
+				PreConfig: func() {
+					putClusterSettings(t, `{"persistent": {"cluster.remote.authoritative_test.seeds": "127.0.0.1:9300", "slm.retention_duration": "2h"}}`)
+					t.Cleanup(func() {
+						putClusterSettings(t, `{"persistent": {"cluster.remote.authoritative_test.seeds": null, "slm.retention_duration": null}}`)
+					})
+				},
+				Config: testAccResourceClusterSettingsAuthoritative,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_cluster_settings.test", "persistent.0.setting.#", "1"),
+					checkClusterSettingPresent("cluster.remote.authoritative_test.seeds"),
+					checkClusterSettingPresent("slm.retention_duration"),
+				),
+			},
+			{
+				ResourceName:            "elasticstack_elasticsearch_cluster_settings.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"authoritative"},
+			},
+		},
+	})
+}
+
+const testAccResourceClusterSettingsUnknown = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_cluster_settings" "test" {
+  persistent {
+    setting {
+      name  = "indices.lifecycle.poll_intervall"
+      value = "5m"
+    }
+  }
+}
+`
+
+const testAccResourceClusterSettingsAuthoritative = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_cluster_settings" "test" {
+  authoritative = true
+
+  persistent {
+    setting {
+      name  = "indices.lifecycle.poll_interval"
+      value = "5m"
+    }
+  }
+}
+`
+
+func putClusterSettings(t *testing.T, settings string) {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.GetESClient().Cluster.PutSettings(bytes.NewReader([]byte(settings)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		t.Fatalf("unable to update the cluster settings: %s", res.String())
+	}
+}
+
+func checkClusterSettingAbsent(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.GetESClient().Cluster.GetSettings(client.GetESClient().Cluster.GetSettings.WithFlatSettings(true))
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		clusterSettings := make(map[string]map[string]interface{})
+		if err := json.NewDecoder(res.Body).Decode(&clusterSettings); err != nil {
+			return err
+		}
+		if v, ok := clusterSettings["persistent"][name]; ok {
+			return fmt.Errorf(`Setting "%s=%s" still in the cluster, but it should be removed`, name, v)
+		}
+		return nil
+	}
+}
+
+func checkClusterSettingPresent(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.GetESClient().Cluster.GetSettings(client.GetESClient().Cluster.GetSettings.WithFlatSettings(true))
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		clusterSettings := make(map[string]map[string]interface{})
+		if err := json.NewDecoder(res.Body).Decode(&clusterSettings); err != nil {
+			return err
+		}
+		if _, ok := clusterSettings["persistent"][name]; !ok {
+			return fmt.Errorf(`Setting "%s" not in the cluster, but it should be kept`, name)
+		}
+		return nil
+	}
+}
+
 func testAccResourceClusterSettingsCreate() string {
 	return `
 provider "elasticstack" {
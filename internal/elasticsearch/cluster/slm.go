@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
@@ -134,6 +135,23 @@ func ResourceSlm() *schema.Resource {
 			Type:        schema.TypeString,
 			Required:    true,
 		},
+		"execute_on_create": {
+			Description: "If `true`, the policy is executed right after it's created and the provider waits for the snapshot to succeed. A partial snapshot fails the apply, unless `partial` is `true`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"execute_on_change": {
+			Description: "If `true`, the policy is executed each time it's updated and the provider waits for the snapshot to succeed.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"last_executed_snapshot": {
+			Description: "Name of the last snapshot taken when executing the policy from Terraform.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 	}
 
 	utils.AddConnectionSchema(slmSchema)
@@ -150,6 +168,11 @@ func ResourceSlm() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: slmSchema,
 	}
 }
@@ -164,6 +187,7 @@ func resourceSlmPut(ctx context.Context, d *schema.ResourceData, meta interface{
 	if diags.HasError() {
 		return diags
 	}
+	isNew := d.Id() == ""
 
 	var slm models.SnapshotPolicy
 	slm.Id = slmId
@@ -233,7 +257,29 @@ func resourceSlmPut(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diags
 	}
 	d.SetId(id.String())
-	return resourceSlmRead(ctx, d, meta)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	execute := !isNew && d.Get("execute_on_change").(bool) && d.HasChangesExcept("execute_on_create", "execute_on_change")
+	if isNew {
+		timeout = d.Timeout(schema.TimeoutCreate)
+		execute = d.Get("execute_on_create").(bool)
+	}
+	var waitDiags diag.Diagnostics
+	if execute {
+		snapshotName, diags := elasticsearch.ExecuteSlm(ctx, client, slmId)
+		if diags.HasError() {
+			return diags
+		}
+		if err := d.Set("last_executed_snapshot", snapshotName); err != nil {
+			return diag.FromErr(err)
+		}
+		waitDiags = waitForSnapshot(ctx, client, slm.Repository, snapshotName, slmConfig.Partial != nil && *slmConfig.Partial, timeout)
+		if waitDiags.HasError() {
+			return waitDiags
+		}
+	}
+
+	return append(waitDiags, resourceSlmRead(ctx, d, meta)...)
 }
 
 func resourceSlmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,222 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSlm() *schema.Resource {
+	invocationSchema := map[string]*schema.Schema{
+		"snapshot_name": {
+			Description: "Name of the snapshot.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"time": {
+			Description: "The time of the invocation, in RFC 3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"time_in_millis": {
+			Description: "The time of the invocation, in milliseconds since the Unix epoch.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"details": {
+			Description: "Details about the failure.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	slmSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "ID of the snapshot lifecycle policy.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"snapshot_name": {
+			Description: "Name automatically assigned to each snapshot created by the policy.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"repository": {
+			Description: "Repository used to store snapshots created by this policy.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"schedule": {
+			Description: "Periodic or absolute schedule at which the policy creates snapshots.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"version": {
+			Description: "The version of the policy, incremented on each update.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"modified_date": {
+			Description: "The time the policy was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_success": {
+			Description: "The last snapshot successfully taken by the policy.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: invocationSchema,
+			},
+		},
+		"last_failure": {
+			Description: "The last snapshot the policy failed to take.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: invocationSchema,
+			},
+		},
+		"next_execution": {
+			Description: "The time of the next scheduled snapshot.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"next_execution_in_millis": {
+			Description: "The time of the next scheduled snapshot, in milliseconds since the Unix epoch.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"stats": {
+			Description: "Statistics about the snapshots taken and deleted by the policy.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"snapshots_taken": {
+						Description: "The number of snapshots taken by the policy.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"snapshots_failed": {
+						Description: "The number of snapshots the policy failed to take.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"snapshots_deleted": {
+						Description: "The number of snapshots deleted by the retention of the policy.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"snapshot_deletion_failures": {
+						Description: "The number of snapshots the retention of the policy failed to delete.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(slmSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves a snapshot lifecycle policy together with its execution status. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/slm-api-get-policy.html",
+
+		ReadContext: dataSourceSlmRead,
+
+		Schema: slmSchema,
+	}
+}
+
+func dataSourceSlmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	slmName := d.Get("name").(string)
+	id, diags := client.ID(ctx, slmName)
+	if diags.HasError() {
+		return diags
+	}
+
+	slm, diags := elasticsearch.GetSlmInfo(ctx, client, slmName)
+	if diags.HasError() {
+		return diags
+	}
+	if slm == nil {
+		return diag.Errorf(`Unable to find the SLM policy "%s"`, slmName)
+	}
+
+	if err := d.Set("snapshot_name", slm.Policy.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("repository", slm.Policy.Repository); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("schedule", slm.Policy.Schedule); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("version", slm.Version); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("modified_date", formatMillis(slm.ModifiedDateMillis)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_success", flattenSlmInvocation(slm.LastSuccess)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_failure", flattenSlmInvocation(slm.LastFailure)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("next_execution", formatMillis(slm.NextExecutionMillis)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("next_execution_in_millis", slm.NextExecutionMillis); err != nil {
+		return diag.FromErr(err)
+	}
+	stats := map[string]interface{}{
+		"snapshots_taken":            slm.Stats.SnapshotsTaken,
+		"snapshots_failed":           slm.Stats.SnapshotsFailed,
+		"snapshots_deleted":          slm.Stats.SnapshotsDeleted,
+		"snapshot_deletion_failures": slm.Stats.SnapshotDeletionFailures,
+	}
+	if err := d.Set("stats", []interface{}{stats}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
+
+func flattenSlmInvocation(invocation *models.SnapshotPolicyInvocation) []interface{} {
+	if invocation == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"snapshot_name":  invocation.SnapshotName,
+			"time":           formatMillis(invocation.TimeMillis),
+			"time_in_millis": invocation.TimeMillis,
+			"details":        invocation.Details,
+		},
+	}
+}
+
+func formatMillis(millis int64) string {
+	if millis == 0 {
+		return ""
+	}
+	return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+}
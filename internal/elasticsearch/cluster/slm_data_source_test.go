@@ -0,0 +1,61 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSlm(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSlm(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_lifecycle.test", "name", name),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_lifecycle.test", "repository", fmt.Sprintf("%s-repo", name)),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_lifecycle.test", "schedule", "0 30 1 * * ?"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_lifecycle.test", "snapshot_name", "<daily-snap-{now/d}>"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_lifecycle.test", "last_success.#", "0"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_lifecycle.test", "stats.0.snapshots_taken", "0"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_lifecycle.test", "next_execution"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSlm(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%s-repo"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+resource "elasticstack_elasticsearch_snapshot_lifecycle" "test_slm" {
+  name = "%s"
+
+  schedule      = "0 30 1 * * ?"
+  snapshot_name = "<daily-snap-{now/d}>"
+  repository    = elasticstack_elasticsearch_snapshot_repository.repo.name
+}
+
+data "elasticstack_elasticsearch_snapshot_lifecycle" "test" {
+  name = elasticstack_elasticsearch_snapshot_lifecycle.test_slm.name
+}
+	`, name, name)
+}
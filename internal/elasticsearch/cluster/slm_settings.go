@@ -0,0 +1,155 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	slmRetentionScheduleSetting = "slm.retention_schedule"
+	slmRetentionDurationSetting = "slm.retention_duration"
+)
+
+func ResourceSlmSettings() *schema.Resource {
+	slmSettingsSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"enabled": {
+			Description: "If `true`, snapshot lifecycle management is running. Setting it to `false` stops all the snapshot lifecycle management operations.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"retention_schedule": {
+			Description: "Periodic or absolute schedule at which the retention of all the policies is applied, e.g. `0 30 1 * * ?`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"retention_duration": {
+			Description: "Limits how long snapshot lifecycle management should spend deleting old snapshots, e.g. `1h`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"operation_mode": {
+			Description: "The current operation mode of snapshot lifecycle management: `RUNNING`, `STOPPING` or `STOPPED`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(slmSettingsSchema)
+
+	return &schema.Resource{
+		Description: "Manages the cluster wide snapshot lifecycle management settings and starts or stops snapshot lifecycle management. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/snapshot-settings.html",
+
+		CreateContext: resourceSlmSettingsPut,
+		UpdateContext: resourceSlmSettingsPut,
+		ReadContext:   resourceSlmSettingsRead,
+		DeleteContext: resourceSlmSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: slmSettingsSchema,
+	}
+}
+
+func resourceSlmSettingsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	id, diags := client.ID(ctx, "slm-settings")
+	if diags.HasError() {
+		return diags
+	}
+
+	settings := map[string]interface{}{
+		slmRetentionScheduleSetting: nil,
+		slmRetentionDurationSetting: nil,
+	}
+	if v, ok := d.GetOk("retention_schedule"); ok {
+		settings[slmRetentionScheduleSetting] = v.(string)
+	}
+	if v, ok := d.GetOk("retention_duration"); ok {
+		settings[slmRetentionDurationSetting] = v.(string)
+	}
+	if diags := elasticsearch.PutSettings(ctx, client, map[string]interface{}{"persistent": settings}); diags.HasError() {
+		return diags
+	}
+
+	if d.Get("enabled").(bool) {
+		diags = elasticsearch.StartSlm(ctx, client)
+	} else {
+		diags = elasticsearch.StopSlm(ctx, client)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceSlmSettingsRead(ctx, d, meta)
+}
+
+func resourceSlmSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	clusterSettings, diags := elasticsearch.GetSettings(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	persistent, _ := clusterSettings["persistent"].(map[string]interface{})
+	retentionSchedule, _ := persistent[slmRetentionScheduleSetting].(string)
+	if err := d.Set("retention_schedule", retentionSchedule); err != nil {
+		return diag.FromErr(err)
+	}
+	retentionDuration, _ := persistent[slmRetentionDurationSetting].(string)
+	if err := d.Set("retention_duration", retentionDuration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	operationMode, diags := elasticsearch.GetSlmStatus(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	if err := d.Set("operation_mode", operationMode); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", operationMode == "RUNNING"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSlmSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	settings := map[string]interface{}{
+		slmRetentionScheduleSetting: nil,
+		slmRetentionDurationSetting: nil,
+	}
+	if diags := elasticsearch.PutSettings(ctx, client, map[string]interface{}{"persistent": settings}); diags.HasError() {
+		return diags
+	}
+	// snapshot lifecycle management is running by default
+	if diags := elasticsearch.StartSlm(ctx, client); diags.HasError() {
+		return diags
+	}
+	return diags
+}
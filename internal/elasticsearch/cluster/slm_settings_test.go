@@ -0,0 +1,73 @@
+package cluster_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSlmSettings(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSlmSettingsDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSlmSettings("0 30 1 * * ?", "2h", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle_settings.test", "retention_schedule", "0 30 1 * * ?"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle_settings.test", "retention_duration", "2h"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle_settings.test", "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccResourceSlmSettings("0 0 2 * * ?", "30m", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle_settings.test", "retention_schedule", "0 0 2 * * ?"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle_settings.test", "retention_duration", "30m"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle_settings.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle_settings.test", "operation_mode", "RUNNING"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSlmSettings(schedule, duration string, enabled bool) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_lifecycle_settings" "test" {
+  retention_schedule = "%s"
+  retention_duration = "%s"
+  enabled            = %t
+}
+	`, schedule, duration, enabled)
+}
+
+func checkResourceSlmSettingsDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	settings, diags := elasticsearch.GetSettings(context.Background(), client)
+	if diags.HasError() {
+		return fmt.Errorf("Unable to get cluster settings %v", diags)
+	}
+	persistent, _ := settings["persistent"].(map[string]interface{})
+	for _, k := range []string{"slm.retention_schedule", "slm.retention_duration"} {
+		if _, ok := persistent[k]; ok {
+			return fmt.Errorf("Setting (%s) still exists", k)
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package cluster_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
@@ -79,6 +80,61 @@ resource "elasticstack_elasticsearch_snapshot_lifecycle" "test_slm" {
 	`, name, name)
 }
 
+func TestAccResourceSLMExecute(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkSlmDestroy(name),
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlmExecute(name, "0 30 1 * * ?"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "execute_on_create", "true"),
+					resource.TestMatchResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "last_executed_snapshot", regexp.MustCompile("^exec-snap-")),
+				),
+			},
+			{
+				Config: testAccSlmExecute(name, "0 30 2 * * ?"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "schedule", "0 30 2 * * ?"),
+					resource.TestMatchResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "last_executed_snapshot", regexp.MustCompile("^exec-snap-")),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlmExecute(name, schedule string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%s-repo"
+
+  fs {
+    location = "/tmp/%s"
+  }
+}
+
+resource "elasticstack_elasticsearch_snapshot_lifecycle" "test_slm" {
+  name = "%s"
+
+  schedule      = "%s"
+  snapshot_name = "<exec-snap-{now/d}>"
+  repository    = elasticstack_elasticsearch_snapshot_repository.repo.name
+
+  include_global_state = false
+
+  execute_on_create = true
+  execute_on_change = true
+}
+	`, name, name, name, schedule)
+}
+
 func checkSlmDestroy(name string) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		client, err := clients.NewAcceptanceTestingClient()
@@ -0,0 +1,347 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSnapshot() *schema.Resource {
+	snapshotSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"repository": {
+			Description: "Name of the repository to store the snapshot in.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "Name of the snapshot. Must be unique in the repository.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"indices": {
+			Description: "List of data streams and indices to include in the snapshot. Multi-index syntax is supported. Defaults to all data streams and indices.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ignore_unavailable": {
+			Description: "If `false`, the snapshot fails if any data stream or index in indices is missing or closed. If `true`, the snapshot ignores missing or closed data streams and indices.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+		},
+		"include_global_state": {
+			Description: "If `true`, include the cluster state in the snapshot.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			ForceNew:    true,
+		},
+		"feature_states": {
+			Description: "Feature states to include in the snapshot. To exclude all feature states, use `[\"none\"]`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"partial": {
+			Description: "If `false`, the entire snapshot will fail if one or more indices included in the snapshot do not have all primary shards available. A partial snapshot fails the apply, unless `partial` is `true` in which case it is reported as a warning.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+		},
+		"metadata": {
+			Description:      "Attaches arbitrary metadata to the snapshot.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"delete_on_destroy": {
+			Description: "If `true`, the snapshot is deleted from the repository when the resource is destroyed. Otherwise the snapshot is only removed from the Terraform state.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"uuid": {
+			Description: "The UUID of the snapshot.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"state": {
+			Description: "The state of the snapshot, e.g. `SUCCESS` or `PARTIAL`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"start_time": {
+			Description: "The time the snapshot started.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"end_time": {
+			Description: "The time the snapshot finished.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"shards_total": {
+			Description: "The total number of shards in the snapshot.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"shards_successful": {
+			Description: "The number of shards that were successfully snapshotted.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"shards_failed": {
+			Description: "The number of shards that failed to be snapshotted.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(snapshotSchema)
+
+	return &schema.Resource{
+		Description: "Creates a snapshot and waits for it to complete. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/create-snapshot-api.html",
+
+		CreateContext: resourceSnapshotCreate,
+		UpdateContext: resourceSnapshotRead,
+		ReadContext:   resourceSnapshotRead,
+		DeleteContext: resourceSnapshotDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSnapshotImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: snapshotSchema,
+	}
+}
+
+func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	repository := d.Get("repository").(string)
+	name := d.Get("name").(string)
+	id, diags := client.ID(ctx, name)
+	if diags.HasError() {
+		return diags
+	}
+
+	ignoreUnavailable := d.Get("ignore_unavailable").(bool)
+	includeGlobalState := d.Get("include_global_state").(bool)
+	partial := d.Get("partial").(bool)
+	config := models.SnapshotPolicyConfig{
+		IgnoreUnavailable:  &ignoreUnavailable,
+		IncludeGlobalState: &includeGlobalState,
+		Partial:            &partial,
+	}
+	for _, i := range d.Get("indices").([]interface{}) {
+		config.Indices = append(config.Indices, i.(string))
+	}
+	for _, f := range d.Get("feature_states").([]interface{}) {
+		config.FeatureStates = append(config.FeatureStates, f.(string))
+	}
+	if v, ok := d.GetOk("metadata"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &config.Metadata); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if diags := elasticsearch.CreateSnapshot(ctx, client, repository, name, &config); diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	diags = waitForSnapshot(ctx, client, repository, name, partial, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceSnapshotRead(ctx, d, meta)...)
+}
+
+// waitForSnapshot polls the status of the snapshot until it's completed, a partial snapshot is only accepted with a warning when allowed
+func waitForSnapshot(ctx context.Context, client *clients.ApiClient, repository, name string, partial bool, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		status, statusDiags := elasticsearch.GetSnapshotStatus(ctx, client, repository, name)
+		if statusDiags.HasError() {
+			return resource.NonRetryableError(fmt.Errorf("%v", statusDiags))
+		}
+		if status == nil {
+			return resource.RetryableError(fmt.Errorf(`snapshot "%s/%s" not found yet`, repository, name))
+		}
+		completed, completedDiags := CheckSnapshotCompleted(status, partial)
+		if completedDiags.HasError() {
+			return resource.NonRetryableError(fmt.Errorf("%s", completedDiags[0].Summary))
+		}
+		if !completed {
+			return resource.RetryableError(fmt.Errorf(`snapshot "%s/%s" is still in progress: %s`, repository, name, status.State))
+		}
+		diags = append(diags, completedDiags...)
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// CheckSnapshotCompleted returns whether the snapshot is completed, failing unless it succeeded or is partial and partial snapshots are allowed
+func CheckSnapshotCompleted(status *models.SnapshotStatus, partial bool) (bool, diag.Diagnostics) {
+	switch status.State {
+	case "SUCCESS":
+		return true, nil
+	case "PARTIAL":
+		summary := fmt.Sprintf(`Snapshot "%s/%s" is partial, %d of %d shards failed`, status.Repository, status.Snapshot, status.ShardsStats.Failed, status.ShardsStats.Total)
+		if !partial {
+			return true, diag.Errorf("%s, set `partial` to accept partial snapshots", summary)
+		}
+		return true, diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail:   "The shards which failed are missing from the snapshot.",
+		}}
+	case "FAILED", "ABORTED", "MISSING":
+		return true, diag.Errorf(`snapshot "%s/%s" finished with state %s, %d of %d shards failed`, status.Repository, status.Snapshot, status.State, status.ShardsStats.Failed, status.ShardsStats.Total)
+	}
+	return false, nil
+}
+
+func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	repository := d.Get("repository").(string)
+	name := compId.ResourceId
+
+	snapshot, diags := elasticsearch.GetSnapshot(ctx, client, repository, name)
+	if snapshot == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Snapshot "%s/%s" not found, removing from state`, repository, name))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("name", snapshot.Snapshot); err != nil {
+		return diag.FromErr(err)
+	}
+	// the indices are expanded by the cluster, so only track them when they are not configured
+	if _, ok := d.GetOk("indices"); !ok {
+		if err := d.Set("indices", snapshot.Indices); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("include_global_state", snapshot.IncludeGlobalState); err != nil {
+		return diag.FromErr(err)
+	}
+	if snapshot.Metadata != nil {
+		metadata, err := json.Marshal(snapshot.Metadata)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("metadata", string(metadata)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("uuid", snapshot.UUID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("state", snapshot.State); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("start_time", snapshot.StartTime); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("end_time", snapshot.EndTime); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("shards_total", snapshot.Shards.Total); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("shards_successful", snapshot.Shards.Successful); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("shards_failed", snapshot.Shards.Failed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("delete_on_destroy").(bool) {
+		tflog.Info(ctx, fmt.Sprintf(`Snapshot "%s/%s" is kept in the repository, only removing it from state`, d.Get("repository").(string), d.Get("name").(string)))
+		return nil
+	}
+
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeleteSnapshot(ctx, client, d.Get("repository").(string), compId.ResourceId); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+// resourceSnapshotImport accepts IDs in the `<cluster_uuid>/<repository>/<snapshot>` format
+func resourceSnapshotImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid import ID %q, expected format: <cluster_uuid>/<repository>/<snapshot>", d.Id())
+	}
+	if err := d.Set("repository", parts[1]); err != nil {
+		return nil, err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", parts[0], parts[2]))
+	return []*schema.ResourceData{d}, nil
+}
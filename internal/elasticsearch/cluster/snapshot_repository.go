@@ -182,6 +182,34 @@ func ResourceSnapshotRepository() *schema.Resource {
 			Default:      "standard",
 			ValidateFunc: validation.StringInSlice([]string{"standard", "reduced_redundancy", "standard_ia", "onezone_ia", "intelligent_tiering"}, false),
 		},
+		"endpoint": {
+			Description: "Custom S3 service endpoint, overrides the endpoint of the S3 client, e.g. for S3 compatible storage.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"protocol": {
+			Description:  "The protocol to use to connect to S3, overrides the protocol of the S3 client.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"http", "https"}, false),
+		},
+		"region": {
+			Description: "The S3 region to use, overrides the region of the S3 client.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"path_style_access": {
+			Description: "If true, path style access pattern will be used instead of the default virtual hosted-style access.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"disable_chunked_encoding": {
+			Description: "If true, chunked encoding is disabled for all requests to S3, e.g. for S3 compatible storage not supporting it.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
 	}
 
 	hdfsSettings := map[string]*schema.Schema{
@@ -203,6 +231,54 @@ func ResourceSnapshotRepository() *schema.Resource {
 		},
 	}
 
+	sourceDelegateTypes := []string{"source.0.fs", "source.0.gcs", "source.0.azure", "source.0.s3"}
+	sourceSettings := map[string]*schema.Schema{
+		"fs": {
+			Description:  "Shared filesystem repository to delegate to.",
+			Type:         schema.TypeList,
+			ForceNew:     true,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sourceDelegateTypes,
+			Elem: &schema.Resource{
+				Schema: utils.MergeSchemaMaps(commonSettings, commonStdSettings, fsSettings),
+			},
+		},
+		"gcs": {
+			Description:  "Google Cloud Storage repository to delegate to.",
+			Type:         schema.TypeList,
+			ForceNew:     true,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sourceDelegateTypes,
+			Elem: &schema.Resource{
+				Schema: utils.MergeSchemaMaps(commonSettings, gcsSettings),
+			},
+		},
+		"azure": {
+			Description:  "Azure Blob storage repository to delegate to.",
+			Type:         schema.TypeList,
+			ForceNew:     true,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sourceDelegateTypes,
+			Elem: &schema.Resource{
+				Schema: utils.MergeSchemaMaps(commonSettings, azureSettings),
+			},
+		},
+		"s3": {
+			Description:  "AWS S3 repository to delegate to.",
+			Type:         schema.TypeList,
+			ForceNew:     true,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sourceDelegateTypes,
+			Elem: &schema.Resource{
+				Schema: utils.MergeSchemaMaps(commonSettings, s3Settings),
+			},
+		},
+	}
+
 	// --
 
 	snapRepoSchema := map[string]*schema.Schema{
@@ -223,14 +299,30 @@ func ResourceSnapshotRepository() *schema.Resource {
 			Optional:    true,
 			Default:     true,
 		},
+		"cleanup_on_apply": {
+			Description: "If true, the repository is cleaned up on each apply, deleting the data no longer referenced by any existing snapshot.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"deleted_bytes": {
+			Description: "Number of bytes freed by the last cleanup of the repository.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"deleted_blobs": {
+			Description: "Number of binary large objects (blobs) removed by the last cleanup of the repository.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
 		"fs": {
 			Description:   "Shared filesystem repository. Repositories of this type use a shared filesystem to store snapshots. This filesystem must be accessible to all master and data nodes in the cluster.",
 			Type:          schema.TypeList,
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"url", "gcs", "azure", "s3", "hdfs"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"url", "gcs", "azure", "s3", "hdfs", "source"},
+			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs", "source"},
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, commonStdSettings, fsSettings),
 			},
@@ -241,8 +333,8 @@ func ResourceSnapshotRepository() *schema.Resource {
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"fs", "gcs", "azure", "s3", "hdfs"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"fs", "gcs", "azure", "s3", "hdfs", "source"},
+			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs", "source"},
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, commonStdSettings, urlSettings),
 			},
@@ -253,8 +345,8 @@ func ResourceSnapshotRepository() *schema.Resource {
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"fs", "s3", "azure", "hdfs", "url"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"fs", "s3", "azure", "hdfs", "url", "source"},
+			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs", "source"},
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, gcsSettings),
 			},
@@ -265,8 +357,8 @@ func ResourceSnapshotRepository() *schema.Resource {
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"fs", "gcs", "url", "s3", "hdfs"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"fs", "gcs", "url", "s3", "hdfs", "source"},
+			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs", "source"},
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, azureSettings),
 			},
@@ -277,8 +369,8 @@ func ResourceSnapshotRepository() *schema.Resource {
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"fs", "url", "gcs", "azure", "hdfs"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"fs", "url", "gcs", "azure", "hdfs", "source"},
+			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs", "source"},
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, s3Settings),
 			},
@@ -289,12 +381,24 @@ func ResourceSnapshotRepository() *schema.Resource {
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"fs", "url", "gcs", "azure", "s3"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"fs", "url", "gcs", "azure", "s3", "source"},
+			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs", "source"},
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, hdfsSettings),
 			},
 		},
+		"source": {
+			Description:   "Source-only repository. Source-only snapshots only contain stored fields and index metadata, and are stored in the nested delegate repository. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/snapshots-source-only-repository.html",
+			Type:          schema.TypeList,
+			ForceNew:      true,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs", "source"},
+			Elem: &schema.Resource{
+				Schema: sourceSettings,
+			},
+		},
 	}
 
 	utils.AddConnectionSchema(snapRepoSchema)
@@ -307,6 +411,8 @@ func ResourceSnapshotRepository() *schema.Resource {
 		ReadContext:   resourceSnapRepoRead,
 		DeleteContext: resourceSnapRepoDelete,
 
+		CustomizeDiff: resourceSnapRepoCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -340,7 +446,11 @@ func resourceSnapRepoPut(ctx context.Context, d *schema.ResourceData, meta inter
 	for t := range schemaTypes {
 		if v, ok := d.GetOk(t); ok && reflect.TypeOf(v).Kind() == reflect.Slice {
 			snapRepo.Type = t
-			expandFsSettings(v.([]interface{})[0].(map[string]interface{}), snapRepoSettings)
+			settings := v.([]interface{})[0].(map[string]interface{})
+			if t == "source" {
+				settings = expandSourceSettings(settings, snapRepoSettings)
+			}
+			expandFsSettings(settings, snapRepoSettings)
 		}
 	}
 	snapRepo.Settings = snapRepoSettings
@@ -349,9 +459,33 @@ func resourceSnapRepoPut(ctx context.Context, d *schema.ResourceData, meta inter
 		return diags
 	}
 	d.SetId(id.String())
+
+	if d.Get("cleanup_on_apply").(bool) {
+		cleanup, diags := elasticsearch.CleanupSnapshotRepository(ctx, client, repoId)
+		if diags.HasError() {
+			return diags
+		}
+		if err := d.Set("deleted_bytes", cleanup.DeletedBytes); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("deleted_blobs", cleanup.DeletedBlobs); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceSnapRepoRead(ctx, d, meta)
 }
 
+// resourceSnapRepoCustomizeDiff plans a new cleanup of the repository on each apply when requested
+func resourceSnapRepoCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("cleanup_on_apply").(bool) {
+		return nil
+	}
+	if err := d.SetNewComputed("deleted_bytes"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("deleted_blobs")
+}
+
 func expandFsSettings(source, target map[string]interface{}) {
 	for k, v := range source {
 		if !utils.IsEmpty(v) {
@@ -360,6 +494,17 @@ func expandFsSettings(source, target map[string]interface{}) {
 	}
 }
 
+// expandSourceSettings sets the type of the delegate repository and returns its settings
+func expandSourceSettings(source, target map[string]interface{}) map[string]interface{} {
+	for delegateType, v := range source {
+		if delegate, ok := v.([]interface{}); ok && len(delegate) > 0 && delegate[0] != nil {
+			target["delegate_type"] = delegateType
+			return delegate[0].(map[string]interface{})
+		}
+	}
+	return map[string]interface{}{}
+}
+
 func resourceSnapRepoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
@@ -394,7 +539,13 @@ func resourceSnapRepoRead(ctx context.Context, d *schema.ResourceData, meta inte
 	// get the schema of the Elem of the current repo type
 	schemaSettings := ResourceSnapshotRepository().Schema[currentRepo.Type].Elem.(*schema.Resource).Schema
 
-	settings, err := flattenRepoSettings(currentRepo, schemaSettings)
+	var settings []interface{}
+	var err error
+	if currentRepo.Type == "source" {
+		settings, err = flattenSourceRepoSettings(currentRepo, schemaSettings)
+	} else {
+		settings, err = flattenRepoSettings(currentRepo, schemaSettings)
+	}
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -443,6 +594,20 @@ func flattenRepoSettings(r *models.SnapshotRepository, s map[string]*schema.Sche
 	return result, nil
 }
 
+// flattenSourceRepoSettings nests the settings of the source-only repository in the block of its delegate type
+func flattenSourceRepoSettings(r *models.SnapshotRepository, s map[string]*schema.Schema) ([]interface{}, error) {
+	delegateType, _ := r.Settings["delegate_type"].(string)
+	delegateSchema, ok := s[delegateType]
+	if !ok {
+		return nil, fmt.Errorf(`Unsupported delegate type = "%s" of the source-only repository`, delegateType)
+	}
+	settings, err := flattenRepoSettings(r, delegateSchema.Elem.(*schema.Resource).Schema)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{map[string]interface{}{delegateType: settings}}, nil
+}
+
 func resourceSnapRepoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
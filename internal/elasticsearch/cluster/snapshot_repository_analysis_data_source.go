@@ -0,0 +1,237 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var RepositoryAnalysisMinSupportedVersion = version.Must(version.NewVersion("7.12.0"))
+
+func DataSourceSnapshotRepositoryAnalysis() *schema.Resource {
+	analysisSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the snapshot repository to analyze.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"blob_count": {
+			Description:  "The total number of blobs to write to the repository during the test.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      100,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"concurrency": {
+			Description:  "The number of operations to run concurrently during the test.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"read_node_count": {
+			Description:  "The number of nodes on which to read a blob after writing.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"early_read_node_count": {
+			Description:  "The number of nodes on which to perform an early read operation while writing each blob.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      2,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"seed": {
+			Description: "The seed for the pseudo-random number generator used to generate the list of operations performed during the test. Defaults to a random seed.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		"max_blob_size": {
+			Description: "The maximum size of a blob to be written during the test.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "10mb",
+		},
+		"max_total_data_size": {
+			Description: "An upper limit on the total size of all the blobs written during the test.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "1gb",
+		},
+		"timeout": {
+			Description: "Specifies the period of time to wait for the test to complete, e.g. `30s`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "30s",
+			ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+				if _, err := time.ParseDuration(i.(string)); err != nil {
+					return nil, []error{err}
+				}
+				return nil, nil
+			},
+		},
+		"blob_path": {
+			Description: "The path in the repository under which all the blobs were written during the test.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"coordinating_node": {
+			Description: "The name of the node which coordinated the analysis.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"issues_detected": {
+			Description: "The issues detected during the analysis.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"write_count": {
+			Description: "The number of write operations performed in the test.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"write_total_size_bytes": {
+			Description: "The total size of all the blobs written in the test, in bytes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"write_total_elapsed_nanos": {
+			Description: "The total elapsed time spent on writing blobs in the test, in nanoseconds.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"read_count": {
+			Description: "The number of read operations performed in the test.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"read_total_size_bytes": {
+			Description: "The total size of all the blobs or partial blobs read in the test, in bytes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"read_total_elapsed_nanos": {
+			Description: "The total elapsed time spent on reading blobs in the test, in nanoseconds.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"listing_elapsed_nanos": {
+			Description: "The time it took to retrieve a list of all the blobs in the container, in nanoseconds.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"delete_elapsed_nanos": {
+			Description: "The time it took to delete all the blobs in the container, in nanoseconds.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(analysisSchema)
+
+	return &schema.Resource{
+		Description: "Analyzes a snapshot repository for correctness and performance. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/repo-analysis-api.html",
+
+		ReadContext: dataSourceSnapRepoAnalysisRead,
+
+		Schema: analysisSchema,
+	}
+}
+
+func dataSourceSnapRepoAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	repoName := d.Get("name").(string)
+	id, diags := client.ID(ctx, repoName)
+	if diags.HasError() {
+		return diags
+	}
+
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	if serverVersion.LessThan(RepositoryAnalysisMinSupportedVersion) {
+		return diag.Errorf("Repository analysis is supported only for Elasticsearch v%s and above", RepositoryAnalysisMinSupportedVersion.String())
+	}
+
+	timeout, err := time.ParseDuration(d.Get("timeout").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params := models.SnapshotRepositoryAnalyzeParams{
+		BlobCount:          d.Get("blob_count").(int),
+		Concurrency:        d.Get("concurrency").(int),
+		ReadNodeCount:      d.Get("read_node_count").(int),
+		EarlyReadNodeCount: d.Get("early_read_node_count").(int),
+		MaxBlobSize:        d.Get("max_blob_size").(string),
+		MaxTotalDataSize:   d.Get("max_total_data_size").(string),
+		Timeout:            timeout,
+	}
+	if v, ok := d.GetOk("seed"); ok {
+		seed := v.(int)
+		params.Seed = &seed
+	}
+
+	analysis, diags := elasticsearch.AnalyzeSnapshotRepository(ctx, client, repoName, &params)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("blob_path", analysis.BlobPath); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("coordinating_node", analysis.CoordinatingNode.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("issues_detected", analysis.IssuesDetected); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("write_count", analysis.Summary.Write.Count); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("write_total_size_bytes", analysis.Summary.Write.TotalSizeBytes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("write_total_elapsed_nanos", analysis.Summary.Write.TotalElapsedNanos); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("read_count", analysis.Summary.Read.Count); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("read_total_size_bytes", analysis.Summary.Read.TotalSizeBytes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("read_total_elapsed_nanos", analysis.Summary.Read.TotalElapsedNanos); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("listing_elapsed_nanos", analysis.ListingElapsedNanos); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("delete_elapsed_nanos", analysis.DeleteElapsedNanos); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
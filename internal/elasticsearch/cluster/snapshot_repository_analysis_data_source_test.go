@@ -0,0 +1,61 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/cluster"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSnapRepoAnalysis(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(cluster.RepositoryAnalysisMinSupportedVersion),
+				Config:   testAccDataSourceSnapRepoAnalysis(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_analysis.test", "name", name),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_analysis.test", "issues_detected.#", "0"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_analysis.test", "write_count", "10"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_repository_analysis.test", "blob_path"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_repository_analysis.test", "coordinating_node"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSnapRepoAnalysis(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "test_fs_repo" {
+  name = "%s"
+
+  fs {
+    location = "/tmp/%s"
+  }
+}
+
+data "elasticstack_elasticsearch_snapshot_repository_analysis" "test" {
+  name = elasticstack_elasticsearch_snapshot_repository.test_fs_repo.name
+
+  blob_count            = 10
+  max_blob_size         = "1mb"
+  max_total_data_size   = "10mb"
+  read_node_count       = 1
+  early_read_node_count = 1
+  timeout               = "2m"
+}
+	`, name, name)
+}
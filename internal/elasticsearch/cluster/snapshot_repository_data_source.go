@@ -153,6 +153,31 @@ func DataSourceSnapshotRespository() *schema.Resource {
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		"endpoint": {
+			Description: "Custom S3 service endpoint, overrides the endpoint of the S3 client.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"protocol": {
+			Description: "The protocol to use to connect to S3, overrides the protocol of the S3 client.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"region": {
+			Description: "The S3 region to use, overrides the region of the S3 client.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"path_style_access": {
+			Description: "If true, path style access pattern will be used instead of the default virtual hosted-style access.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"disable_chunked_encoding": {
+			Description: "If true, chunked encoding is disabled for all requests to S3.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
 	}
 
 	hdfsSettings := map[string]*schema.Schema{
@@ -173,6 +198,41 @@ func DataSourceSnapshotRespository() *schema.Resource {
 		},
 	}
 
+	sourceSettings := map[string]*schema.Schema{
+		"fs": {
+			Description: "Shared filesystem repository delegated to. Set only if the delegate type is `fs`.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: utils.MergeSchemaMaps(commonSettings, commonStdSettings, fsSettings),
+			},
+		},
+		"gcs": {
+			Description: "Google Cloud Storage repository delegated to. Set only if the delegate type is `gcs`.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: utils.MergeSchemaMaps(commonSettings, gcsSettings),
+			},
+		},
+		"azure": {
+			Description: "Azure Blob storage repository delegated to. Set only if the delegate type is `azure`.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: utils.MergeSchemaMaps(commonSettings, azureSettings),
+			},
+		},
+		"s3": {
+			Description: "AWS S3 repository delegated to. Set only if the delegate type is `s3`.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: utils.MergeSchemaMaps(commonSettings, s3Settings),
+			},
+		},
+	}
+
 	// --
 
 	snapRepoSchema := map[string]*schema.Schema{
@@ -239,6 +299,14 @@ func DataSourceSnapshotRespository() *schema.Resource {
 				Schema: utils.MergeSchemaMaps(commonSettings, hdfsSettings),
 			},
 		},
+		"source": {
+			Description: "Source-only repository, with the settings nested in the block of the delegate type. Set only if the type of the fetched repo is `source`.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: sourceSettings,
+			},
+		},
 	}
 
 	utils.AddConnectionSchema(snapRepoSchema)
@@ -267,9 +335,25 @@ func dataSourceSnapRepoRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diags
 	}
 
+	repoSchema, ok := DataSourceSnapshotRespository().Schema[currentRepo.Type]
+	if !ok {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "API responded with unsupported type of the snapshot repository.",
+			Detail:   fmt.Sprintf(`The type "%s" of the snapshot repository is not supported.`, currentRepo.Type),
+		})
+		return diags
+	}
+
 	// get the schema of the Elem of the current repo type
-	schemaSettings := DataSourceSnapshotRespository().Schema[currentRepo.Type].Elem.(*schema.Resource).Schema
-	settings, err := flattenRepoSettings(currentRepo, schemaSettings)
+	schemaSettings := repoSchema.Elem.(*schema.Resource).Schema
+	var settings []interface{}
+	var err error
+	if currentRepo.Type == "source" {
+		settings, err = flattenSourceRepoSettings(currentRepo, schemaSettings)
+	} else {
+		settings, err = flattenRepoSettings(currentRepo, schemaSettings)
+	}
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -94,3 +94,46 @@ data "elasticstack_elasticsearch_snapshot_repository" "test_url_repo" {
 }
 	`, name)
 }
+
+func TestAccDataSourceSnapRepoSource(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSnapRepoSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository.test_source_repo", "name", name),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository.test_source_repo", "type", "source"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository.test_source_repo", "fs.#", "0"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository.test_source_repo", "source.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository.test_source_repo", "source.0.fs.0.location", "/tmp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSnapRepoSource(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "test_source_repo" {
+  name = "%s"
+
+  source {
+    fs {
+      location = "/tmp"
+    }
+  }
+}
+
+data "elasticstack_elasticsearch_snapshot_repository" "test_source_repo" {
+  name = resource.elasticstack_elasticsearch_snapshot_repository.test_source_repo.name
+}
+	`, name)
+}
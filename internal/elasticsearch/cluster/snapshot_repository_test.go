@@ -65,6 +65,58 @@ func TestAccResourceSnapRepoUrl(t *testing.T) {
 	})
 }
 
+func TestAccResourceSnapRepoSource(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkRepoDestroy(name),
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoSourceCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_source_repo", "name", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_source_repo", "fs.#", "0"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_source_repo", "source.0.fs.0.location", "/tmp"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_source_repo", "source.0.fs.0.compress", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_source_repo", "source.0.fs.0.max_restore_bytes_per_sec", "10mb"),
+				),
+			},
+			{
+				ResourceName:      "elasticstack_elasticsearch_snapshot_repository.test_source_repo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"verify",
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceSnapRepoCleanup(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkRepoDestroy(name),
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoCleanupCreate(name),
+				// the cleanup is planned on each apply
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_cleanup_repo", "cleanup_on_apply", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_cleanup_repo", "deleted_bytes", "0"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_cleanup_repo", "deleted_blobs", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRepoFsCreate(name string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -99,6 +151,42 @@ resource "elasticstack_elasticsearch_snapshot_repository" "test_url_repo" {
 	`, name)
 }
 
+func testAccRepoSourceCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "test_source_repo" {
+  name = "%s"
+
+  source {
+    fs {
+      location                  = "/tmp"
+      max_restore_bytes_per_sec = "10mb"
+    }
+  }
+}
+	`, name)
+}
+
+func testAccRepoCleanupCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "test_cleanup_repo" {
+  name             = "%s"
+  cleanup_on_apply = true
+
+  fs {
+    location = "/tmp/%s"
+  }
+}
+	`, name, name)
+}
+
 func checkRepoDestroy(name string) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		client, err := clients.NewAcceptanceTestingClient()
@@ -0,0 +1,93 @@
+package cluster
+
+import (
+	"context"
+	"sort"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSnapshotRepositoryVerification() *schema.Resource {
+	verificationSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the snapshot repository to verify.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"nodes": {
+			Description: "The nodes which were able to access the repository.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The ID of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(verificationSchema)
+
+	return &schema.Resource{
+		Description: "Verifies that a snapshot repository is functional on all master and data nodes in the cluster. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/verify-snapshot-repo-api.html",
+
+		ReadContext: dataSourceSnapRepoVerificationRead,
+
+		Schema: verificationSchema,
+	}
+}
+
+func dataSourceSnapRepoVerificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	repoName := d.Get("name").(string)
+	id, diags := client.ID(ctx, repoName)
+	if diags.HasError() {
+		return diags
+	}
+
+	nodes, diags := elasticsearch.VerifySnapshotRepository(ctx, client, repoName)
+	if diags.HasError() {
+		return diags
+	}
+
+	// keep a stable order of the nodes between reads
+	nodeIds := make([]string, 0, len(nodes))
+	for nodeId := range nodes {
+		nodeIds = append(nodeIds, nodeId)
+	}
+	sort.Strings(nodeIds)
+	result := make([]interface{}, 0, len(nodeIds))
+	for _, nodeId := range nodeIds {
+		result = append(result, map[string]interface{}{
+			"id":   nodeId,
+			"name": nodes[nodeId],
+		})
+	}
+	if err := d.Set("nodes", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
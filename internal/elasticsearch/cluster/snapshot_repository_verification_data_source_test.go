@@ -0,0 +1,50 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSnapRepoVerification(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSnapRepoVerification(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verification.test", "name", name),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshot_repository_verification.test", "nodes.#", "1"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_repository_verification.test", "nodes.0.id"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshot_repository_verification.test", "nodes.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSnapRepoVerification(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "test_fs_repo" {
+  name = "%s"
+
+  fs {
+    location = "/tmp"
+  }
+}
+
+data "elasticstack_elasticsearch_snapshot_repository_verification" "test" {
+  name = elasticstack_elasticsearch_snapshot_repository.test_fs_repo.name
+}
+	`, name)
+}
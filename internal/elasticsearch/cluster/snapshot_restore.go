@@ -0,0 +1,301 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSnapshotRestore() *schema.Resource {
+	restoreSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"repository": {
+			Description: "Name of the repository to restore the snapshot from.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"snapshot": {
+			Description: "Name of the snapshot to restore.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"indices": {
+			Description: "List of data streams and indices to restore. Multi-index syntax is supported. Defaults to all regular data streams and indices in the snapshot.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ignore_unavailable": {
+			Description: "If `true`, the request ignores any index or data stream in indices that's missing from the snapshot.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+		},
+		"include_global_state": {
+			Description: "If `true`, restore the cluster state.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+		},
+		"feature_states": {
+			Description: "Feature states to restore. To restore no feature states, use `[\"none\"]`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"include_aliases": {
+			Description: "If `true`, the request restores aliases for any restored data streams and indices.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			ForceNew:    true,
+		},
+		"partial": {
+			Description: "If `false`, the entire restore operation will fail if one or more indices included in the snapshot do not have all primary shards available.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+		},
+		"rename_pattern": {
+			Description: "Defines a rename pattern to apply to restored data streams and indices. Data streams and indices matching the rename pattern will be renamed according to `rename_replacement`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"rename_replacement": {
+			Description:  "Defines the rename replacement string, e.g. `restored-$1`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			RequiredWith: []string{"rename_pattern"},
+		},
+		"index_settings": {
+			Description:      "Index settings to add or change in restored indices, including backing indices.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"ignore_index_settings": {
+			Description: "Index settings to not restore from the snapshot.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"delete_on_destroy": {
+			Description: "If `true`, the restored indices and data streams are deleted when the resource is destroyed. Otherwise they are kept in the cluster.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"restored_indices": {
+			Description: "The indices restored from the snapshot, including backing indices of data streams.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"shards_total": {
+			Description: "The total number of shards restored.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"shards_successful": {
+			Description: "The number of shards that were successfully restored.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"shards_failed": {
+			Description: "The number of shards that failed to be restored.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(restoreSchema)
+
+	return &schema.Resource{
+		Description: "Restores data streams and indices from a snapshot and waits for the recovery to finish. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/restore-snapshot-api.html",
+
+		CreateContext: resourceSnapshotRestoreCreate,
+		UpdateContext: resourceSnapshotRestoreRead,
+		ReadContext:   resourceSnapshotRestoreRead,
+		DeleteContext: resourceSnapshotRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: restoreSchema,
+	}
+}
+
+func resourceSnapshotRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	repository := d.Get("repository").(string)
+	snapshot := d.Get("snapshot").(string)
+	id, diags := client.ID(ctx, snapshot)
+	if diags.HasError() {
+		return diags
+	}
+
+	ignoreUnavailable := d.Get("ignore_unavailable").(bool)
+	includeGlobalState := d.Get("include_global_state").(bool)
+	includeAliases := d.Get("include_aliases").(bool)
+	partial := d.Get("partial").(bool)
+	restore := models.SnapshotRestore{
+		IgnoreUnavailable:  &ignoreUnavailable,
+		IncludeGlobalState: &includeGlobalState,
+		IncludeAliases:     &includeAliases,
+		Partial:            &partial,
+		RenamePattern:      d.Get("rename_pattern").(string),
+		RenameReplacement:  d.Get("rename_replacement").(string),
+	}
+	for _, i := range d.Get("indices").([]interface{}) {
+		restore.Indices = append(restore.Indices, i.(string))
+	}
+	for _, f := range d.Get("feature_states").([]interface{}) {
+		restore.FeatureStates = append(restore.FeatureStates, f.(string))
+	}
+	for _, s := range d.Get("ignore_index_settings").([]interface{}) {
+		restore.IgnoreIndexSettings = append(restore.IgnoreIndexSettings, s.(string))
+	}
+	if v, ok := d.GetOk("index_settings"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &restore.IndexSettings); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// the restore request blocks until the recovery of all the restored shards is finished
+	restoreCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	info, diags := elasticsearch.RestoreSnapshot(restoreCtx, client, repository, snapshot, &restore)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if err := d.Set("restored_indices", info.Indices); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("shards_total", info.Shards.Total); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("shards_successful", info.Shards.Successful); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("shards_failed", info.Shards.Failed); err != nil {
+		return diag.FromErr(err)
+	}
+	if info.Shards.Failed > 0 && !partial {
+		return diag.Errorf(`Restore of snapshot "%s/%s" finished with %d of %d failed shards`, repository, snapshot, info.Shards.Failed, info.Shards.Total)
+	}
+
+	return resourceSnapshotRestoreRead(ctx, d, meta)
+}
+
+func resourceSnapshotRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	// the restore is a one-off operation, the resource is only gone once none of the restored indices are left
+	restoredIndices := d.Get("restored_indices").([]interface{})
+	if len(restoredIndices) == 0 {
+		return diags
+	}
+	for _, i := range restoredIndices {
+		index, diags := elasticsearch.GetIndex(ctx, client, i.(string))
+		if diags.HasError() {
+			return diags
+		}
+		if index != nil {
+			return diags
+		}
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf(`Indices restored from snapshot "%s/%s" not found, removing from state`, d.Get("repository").(string), d.Get("snapshot").(string)))
+	d.SetId("")
+	return diags
+}
+
+func resourceSnapshotRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("delete_on_destroy").(bool) {
+		tflog.Info(ctx, fmt.Sprintf(`Indices restored from snapshot "%s/%s" are kept, only removing the restore from state`, d.Get("repository").(string), d.Get("snapshot").(string)))
+		return nil
+	}
+
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	dataStreams := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, i := range d.Get("restored_indices").([]interface{}) {
+		index, diags := elasticsearch.GetIndex(ctx, client, i.(string))
+		if diags.HasError() {
+			return diags
+		}
+		if index == nil {
+			continue
+		}
+		// backing indices can only be removed together with their data stream
+		if index.DataStream != "" {
+			if !seen[index.DataStream] {
+				seen[index.DataStream] = true
+				dataStreams = append(dataStreams, index.DataStream)
+			}
+			continue
+		}
+		if diags := elasticsearch.DeleteIndex(ctx, client, i.(string)); diags.HasError() {
+			return diags
+		}
+	}
+	for _, name := range dataStreams {
+		dataStream, diags := elasticsearch.GetDataStream(ctx, client, name)
+		if diags.HasError() {
+			return diags
+		}
+		if dataStream == nil {
+			continue
+		}
+		if diags := elasticsearch.DeleteDataStream(ctx, client, name); diags.HasError() {
+			return diags
+		}
+	}
+	return diags
+}
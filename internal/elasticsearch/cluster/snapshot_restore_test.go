@@ -0,0 +1,205 @@
+package cluster_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSnapshotRestore(t *testing.T) {
+	// generate a random name
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSnapshotRestoreDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSnapshotRestoreCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_restore.test", "snapshot", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_restore.test", "restored_indices.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_restore.test", "restored_indices.0", fmt.Sprintf("restored-%s", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_restore.test", "shards_total", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_restore.test", "shards_successful", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_restore.test", "shards_failed", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSnapshotRestoreDataStream(t *testing.T) {
+	// generate a random name
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSnapshotRestoreDataStreamDestroy(fmt.Sprintf("restored-%s-ds", name)),
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSnapshotRestoreDataStream(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_restore.test", "restored_indices.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_restore.test", "shards_failed", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSnapshotRestoreCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%s"
+
+  fs {
+    location = "/tmp/%s"
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name = "%s"
+
+  number_of_shards   = 1
+  number_of_replicas = 0
+}
+
+resource "elasticstack_elasticsearch_snapshot" "test" {
+  repository = elasticstack_elasticsearch_snapshot_repository.repo.name
+  name       = "%s"
+
+  indices              = [elasticstack_elasticsearch_index.test.name]
+  include_global_state = false
+
+  delete_on_destroy = true
+}
+
+resource "elasticstack_elasticsearch_snapshot_restore" "test" {
+  repository = elasticstack_elasticsearch_snapshot.test.repository
+  snapshot   = elasticstack_elasticsearch_snapshot.test.name
+
+  indices            = [elasticstack_elasticsearch_index.test.name]
+  rename_pattern     = "(.+)"
+  rename_replacement = "restored-$1"
+
+  index_settings = jsonencode({
+    "index.number_of_replicas" = 0
+  })
+  ignore_index_settings = ["index.refresh_interval"]
+
+  delete_on_destroy = true
+}
+	`, name, name, name, name)
+}
+
+func testAccResourceSnapshotRestoreDataStream(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%[1]s"
+
+  fs {
+    location = "/tmp/%[1]s"
+  }
+}
+
+resource "elasticstack_elasticsearch_index_template" "test" {
+  name = "%[1]s"
+
+  index_patterns = ["%[1]s-ds*", "restored-%[1]s-ds*"]
+
+  template {
+    settings = jsonencode({
+      number_of_shards   = 1
+      number_of_replicas = 0
+    })
+  }
+
+  data_stream {}
+}
+
+resource "elasticstack_elasticsearch_data_stream" "test" {
+  name = "%[1]s-ds"
+
+  depends_on = [elasticstack_elasticsearch_index_template.test]
+}
+
+resource "elasticstack_elasticsearch_snapshot" "test" {
+  repository = elasticstack_elasticsearch_snapshot_repository.repo.name
+  name       = "%[1]s"
+
+  indices              = [elasticstack_elasticsearch_data_stream.test.name]
+  include_global_state = false
+
+  delete_on_destroy = true
+}
+
+resource "elasticstack_elasticsearch_snapshot_restore" "test" {
+  repository = elasticstack_elasticsearch_snapshot.test.repository
+  snapshot   = elasticstack_elasticsearch_snapshot.test.name
+
+  indices            = [elasticstack_elasticsearch_data_stream.test.name]
+  rename_pattern     = "(.+)"
+  rename_replacement = "restored-$1"
+
+  delete_on_destroy = true
+}
+	`, name)
+}
+
+func checkResourceSnapshotRestoreDataStreamDestroy(dataStreamName string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingClient()
+		if err != nil {
+			return err
+		}
+
+		dataStream, diags := elasticsearch.GetDataStream(context.Background(), client, dataStreamName)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get data stream %v", diags)
+		}
+		if dataStream != nil {
+			return fmt.Errorf("Restored data stream (%s) still exists", dataStreamName)
+		}
+		return nil
+	}
+}
+
+func checkResourceSnapshotRestoreDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_snapshot_restore" {
+			continue
+		}
+
+		index, diags := elasticsearch.GetIndex(context.Background(), client, rs.Primary.Attributes["restored_indices.0"])
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get index %v", diags)
+		}
+		if index != nil {
+			return fmt.Errorf("Restored index (%s) still exists", rs.Primary.Attributes["restored_indices.0"])
+		}
+	}
+	return nil
+}
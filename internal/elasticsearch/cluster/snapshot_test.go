@@ -0,0 +1,236 @@
+package cluster_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/cluster"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSnapshot(t *testing.T) {
+	// generate a random name
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSnapshotDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSnapshotCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "name", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "repository", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "indices.0", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "include_global_state", "false"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "state", "SUCCESS"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "shards_total", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "shards_successful", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "shards_failed", "0"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot.test", "uuid"),
+				),
+			},
+		},
+	})
+}
+
+func TestCheckSnapshotCompleted(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		state         string
+		partial       bool
+		wantCompleted bool
+		wantSeverity  *diag.Severity
+	}{
+		{
+			name:          "succeeds",
+			state:         "SUCCESS",
+			wantCompleted: true,
+		},
+		{
+			name:  "waits for the snapshot in progress",
+			state: "IN_PROGRESS",
+		},
+		{
+			name:          "fails on a partial snapshot",
+			state:         "PARTIAL",
+			wantCompleted: true,
+			wantSeverity:  severity(diag.Error),
+		},
+		{
+			name:          "warns on a partial snapshot when allowed",
+			state:         "PARTIAL",
+			partial:       true,
+			wantCompleted: true,
+			wantSeverity:  severity(diag.Warning),
+		},
+		{
+			name:          "fails on a failed snapshot even when partial snapshots are allowed",
+			state:         "FAILED",
+			partial:       true,
+			wantCompleted: true,
+			wantSeverity:  severity(diag.Error),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			status := models.SnapshotStatus{Snapshot: "snapshot", Repository: "repository", State: tt.state}
+			status.ShardsStats.Failed = 1
+			status.ShardsStats.Total = 2
+
+			completed, diags := cluster.CheckSnapshotCompleted(&status, tt.partial)
+			if completed != tt.wantCompleted {
+				t.Errorf("CheckSnapshotCompleted() completed = %v, want %v", completed, tt.wantCompleted)
+			}
+			if tt.wantSeverity == nil {
+				if len(diags) != 0 {
+					t.Errorf("CheckSnapshotCompleted() diags = %v, want none", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Severity != *tt.wantSeverity {
+				t.Fatalf("CheckSnapshotCompleted() diags = %v, want a single diagnostic of severity %v", diags, *tt.wantSeverity)
+			}
+			if want := `Snapshot "repository/snapshot" is partial, 1 of 2 shards failed`; tt.state == "PARTIAL" && !strings.HasPrefix(diags[0].Summary, want) {
+				t.Errorf("CheckSnapshotCompleted() summary = %q, want it to start with %q", diags[0].Summary, want)
+			}
+		})
+	}
+}
+
+func severity(s diag.Severity) *diag.Severity {
+	return &s
+}
+
+func TestAccResourceSnapshotPartial(t *testing.T) {
+	// generate a random name
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSnapshotDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSnapshotPartial(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "partial", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "state", "PARTIAL"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "shards_total", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "shards_failed", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSnapshotCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%s"
+
+  fs {
+    location = "/tmp/%s"
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name = "%s"
+
+  number_of_shards   = 1
+  number_of_replicas = 0
+}
+
+resource "elasticstack_elasticsearch_snapshot" "test" {
+  repository = elasticstack_elasticsearch_snapshot_repository.repo.name
+  name       = "%s"
+
+  indices              = [elasticstack_elasticsearch_index.test.name]
+  include_global_state = false
+
+  metadata = jsonencode({
+    taken_by = "terraform"
+  })
+
+  delete_on_destroy = true
+}
+	`, name, name, name, name)
+}
+
+// the primary shard of the index is never allocated, so it's missing from the snapshot
+func testAccResourceSnapshotPartial(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%[1]s"
+
+  fs {
+    location = "/tmp/%[1]s"
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name = "%[1]s"
+
+  number_of_shards          = 1
+  number_of_replicas        = 0
+  routing_allocation_enable = "none"
+  wait_for_active_shards    = "0"
+}
+
+resource "elasticstack_elasticsearch_snapshot" "test" {
+  repository = elasticstack_elasticsearch_snapshot_repository.repo.name
+  name       = "%[1]s"
+
+  indices              = [elasticstack_elasticsearch_index.test.name]
+  include_global_state = false
+  partial              = true
+
+  delete_on_destroy = true
+}
+	`, name)
+}
+
+func checkResourceSnapshotDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_snapshot" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+
+		snapshot, diags := elasticsearch.GetSnapshot(context.Background(), client, rs.Primary.Attributes["repository"], compId.ResourceId)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get snapshot %v", diags)
+		}
+		if snapshot != nil {
+			return fmt.Errorf("Snapshot (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
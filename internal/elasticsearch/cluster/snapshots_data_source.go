@@ -0,0 +1,281 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var SnapshotsSortMinSupportedVersion = version.Must(version.NewVersion("7.14.0"))
+
+func DataSourceSnapshots() *schema.Resource {
+	snapshotsSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"repository": {
+			Description: "Name of the repository to list the snapshots from.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"names": {
+			Description: "List of snapshot names to retrieve. Wildcard (`*`) expressions are supported. Defaults to all snapshots in the repository.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"state": {
+			Description:  "Only return the snapshots in the given state, e.g. `SUCCESS`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"IN_PROGRESS", "SUCCESS", "FAILED", "PARTIAL", "INCOMPATIBLE"}, false),
+		},
+		"sort": {
+			Description:  "Allows setting a sort order for the result. Supported from Elasticsearch version **7.14**",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"start_time", "duration", "name", "index_count", "repository", "shard_count", "failed_shard_count"}, false),
+		},
+		"order": {
+			Description:  "Sort order, either `asc` or `desc`. Supported from Elasticsearch version **7.14**",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+		},
+		"size": {
+			Description:  "Maximum number of snapshots to return. Defaults to all the matching snapshots. The snapshots are filtered by `state` before being truncated. Supported from Elasticsearch version **7.14**",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"snapshots": {
+			Description: "The matching snapshots.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "Name of the snapshot.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"uuid": {
+						Description: "The UUID of the snapshot.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"version": {
+						Description: "The version of Elasticsearch which took the snapshot.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"state": {
+						Description: "The state of the snapshot.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"indices": {
+						Description: "The indices included in the snapshot.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"data_streams": {
+						Description: "The data streams included in the snapshot.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"feature_states": {
+						Description: "The feature states included in the snapshot.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"include_global_state": {
+						Description: "If `true`, the cluster state is included in the snapshot.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"metadata": {
+						Description: "Arbitrary metadata attached to the snapshot.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"start_time": {
+						Description: "The time the snapshot started.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"start_time_in_millis": {
+						Description: "The time the snapshot started, in milliseconds since the Unix epoch.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"end_time": {
+						Description: "The time the snapshot finished.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"end_time_in_millis": {
+						Description: "The time the snapshot finished, in milliseconds since the Unix epoch.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"duration_in_millis": {
+						Description: "The duration of the snapshot, in milliseconds.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"shards_total": {
+						Description: "The total number of shards in the snapshot.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"shards_successful": {
+						Description: "The number of shards that were successfully snapshotted.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"shards_failed": {
+						Description: "The number of shards that failed to be snapshotted.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(snapshotsSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves information about the snapshots in a repository. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/get-snapshot-api.html",
+
+		ReadContext: dataSourceSnapshotsRead,
+
+		Schema: snapshotsSchema,
+	}
+}
+
+func dataSourceSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	repository := d.Get("repository").(string)
+
+	names := []string{"_all"}
+	if v, ok := d.GetOk("names"); ok {
+		names = nil
+		for _, n := range v.([]interface{}) {
+			names = append(names, n.(string))
+		}
+	}
+	id, diags := client.ID(ctx, fmt.Sprintf("%s/%s", repository, strings.Join(names, ",")))
+	if diags.HasError() {
+		return diags
+	}
+
+	state := d.Get("state").(string)
+	size := d.Get("size").(int)
+	params := models.GetSnapshotsParams{
+		Sort:  d.Get("sort").(string),
+		Order: d.Get("order").(string),
+		Size:  size,
+	}
+	if params.Sort != "" || params.Order != "" || params.Size > 0 {
+		serverVersion, diags := client.ServerVersion(ctx)
+		if diags.HasError() {
+			return diags
+		}
+		if serverVersion.LessThan(SnapshotsSortMinSupportedVersion) {
+			return diag.Errorf("'sort', 'order' and 'size' are supported only for Elasticsearch v%s and above", SnapshotsSortMinSupportedVersion.String())
+		}
+	}
+
+	// the state is filtered on the client, the snapshots can only be truncated once filtered
+	if state != "" {
+		params.Size = 0
+	}
+	snapshots, diags := elasticsearch.GetSnapshots(ctx, client, repository, names, &params)
+	if diags.HasError() {
+		return diags
+	}
+	snapshots = FilterSnapshots(snapshots, state, size)
+
+	result := make([]interface{}, 0, len(snapshots))
+	for _, s := range snapshots {
+		snapshot := map[string]interface{}{
+			"name":                 s.Snapshot,
+			"uuid":                 s.UUID,
+			"version":              s.Version,
+			"state":                s.State,
+			"indices":              s.Indices,
+			"data_streams":         s.DataStreams,
+			"include_global_state": s.IncludeGlobalState,
+			"start_time":           s.StartTime,
+			"start_time_in_millis": s.StartTimeInMillis,
+			"end_time":             s.EndTime,
+			"end_time_in_millis":   s.EndTimeInMillis,
+			"duration_in_millis":   s.DurationInMillis,
+			"shards_total":         s.Shards.Total,
+			"shards_successful":    s.Shards.Successful,
+			"shards_failed":        s.Shards.Failed,
+		}
+		featureStates := make([]string, 0, len(s.FeatureStates))
+		for _, f := range s.FeatureStates {
+			featureStates = append(featureStates, f.FeatureName)
+		}
+		snapshot["feature_states"] = featureStates
+		if s.Metadata != nil {
+			metadata, err := json.Marshal(s.Metadata)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			snapshot["metadata"] = string(metadata)
+		}
+		result = append(result, snapshot)
+	}
+	if err := d.Set("snapshots", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
+
+// FilterSnapshots returns the first snapshots in the given state, all the states match when empty and all the snapshots are returned when size is 0
+func FilterSnapshots(snapshots []models.SnapshotInfo, state string, size int) []models.SnapshotInfo {
+	filtered := make([]models.SnapshotInfo, 0, len(snapshots))
+	for _, s := range snapshots {
+		if size > 0 && len(filtered) == size {
+			break
+		}
+		if state != "" && s.State != state {
+			continue
+		}
+		filtered = append(filtered, s)
+	}
+	return filtered
+}
@@ -0,0 +1,152 @@
+package cluster_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/cluster"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFilterSnapshots(t *testing.T) {
+	t.Parallel()
+
+	// sorted by start time, newest first
+	snapshots := []models.SnapshotInfo{
+		{Snapshot: "in-progress", State: "IN_PROGRESS"},
+		{Snapshot: "partial", State: "PARTIAL"},
+		{Snapshot: "newest-success", State: "SUCCESS"},
+		{Snapshot: "failed", State: "FAILED"},
+		{Snapshot: "oldest-success", State: "SUCCESS"},
+	}
+
+	tests := []struct {
+		name  string
+		state string
+		size  int
+		want  []string
+	}{
+		{
+			name: "returns all the snapshots",
+			want: []string{"in-progress", "partial", "newest-success", "failed", "oldest-success"},
+		},
+		{
+			name: "truncates the snapshots",
+			size: 2,
+			want: []string{"in-progress", "partial"},
+		},
+		{
+			name:  "filters the snapshots by state",
+			state: "SUCCESS",
+			want:  []string{"newest-success", "oldest-success"},
+		},
+		{
+			name:  "returns the latest successful snapshot when newer ones are not successful",
+			state: "SUCCESS",
+			size:  1,
+			want:  []string{"newest-success"},
+		},
+		{
+			name:  "returns no snapshot when none is in the state",
+			state: "INCOMPATIBLE",
+			size:  1,
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := make([]string, 0)
+			for _, s := range cluster.FilterSnapshots(snapshots, tt.state, tt.size) {
+				got = append(got, s.Snapshot)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterSnapshots() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccDataSourceSnapshots(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSnapshots(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshots.test", "snapshots.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshots.test", "snapshots.0.state", "SUCCESS"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshots.test", "snapshots.0.indices.0", name),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshots.test", "snapshots.0.shards_failed", "0"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(cluster.SnapshotsSortMinSupportedVersion),
+				Config: testAccDataSourceSnapshots(name, `
+  sort  = "name"
+  order = "desc"
+  size  = 1
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshots.test", "snapshots.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshots.test", "snapshots.0.name", fmt.Sprintf("%s-2", name)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSnapshots(name, extra string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%s"
+
+  fs {
+    location = "/tmp/%s"
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name = "%s"
+
+  number_of_shards   = 1
+  number_of_replicas = 0
+}
+
+resource "elasticstack_elasticsearch_snapshot" "first" {
+  repository = elasticstack_elasticsearch_snapshot_repository.repo.name
+  name       = "%s-1"
+
+  indices           = [elasticstack_elasticsearch_index.test.name]
+  delete_on_destroy = true
+}
+
+resource "elasticstack_elasticsearch_snapshot" "second" {
+  repository = elasticstack_elasticsearch_snapshot.first.repository
+  name       = "%s-2"
+
+  indices           = [elasticstack_elasticsearch_index.test.name]
+  delete_on_destroy = true
+}
+
+data "elasticstack_elasticsearch_snapshots" "test" {
+  repository = elasticstack_elasticsearch_snapshot.second.repository
+  names      = ["%s-*"]
+  state      = "SUCCESS"
+%s
+}
+	`, name, name, name, name, name, name, extra)
+}
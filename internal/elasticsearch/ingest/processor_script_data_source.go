@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"strings"
 
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -77,6 +79,26 @@ func DataSourceProcessorScript() *schema.Resource {
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
+		"validation": {
+			Description: "Validates the script by running the processor against sample documents with the simulate pipeline API. Compilation and runtime errors are reported along with their line and column.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"documents": {
+						Description: "Sample documents to run the processor against. Defaults to a single empty document.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type:             schema.TypeString,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: utils.DiffJsonSuppress,
+						},
+					},
+				},
+			},
+		},
 		"json": {
 			Description: "JSON representation of this data source.",
 			Type:        schema.TypeString,
@@ -84,6 +106,8 @@ func DataSourceProcessorScript() *schema.Resource {
 		},
 	}
 
+	utils.AddConnectionSchema(processorSchema)
+
 	return &schema.Resource{
 		Description: "Runs an inline or stored script on incoming documents. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/script-processor.html",
 
@@ -137,6 +161,30 @@ func dataSourceProcessorScriptRead(ctx context.Context, d *schema.ResourceData,
 		processor.OnFailure = onFailure
 	}
 
+	if v, ok := d.GetOk("validation"); ok {
+		docs := make([]map[string]interface{}, 0)
+		if settings, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			for _, doc := range settings["documents"].([]interface{}) {
+				item := make(map[string]interface{})
+				if err := json.NewDecoder(strings.NewReader(doc.(string))).Decode(&item); err != nil {
+					return diag.FromErr(err)
+				}
+				docs = append(docs, item)
+			}
+		}
+		if len(docs) == 0 {
+			docs = append(docs, make(map[string]interface{}))
+		}
+
+		client, diags := clients.NewApiClient(d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		if diags := elasticsearch.SimulateScriptProcessor(ctx, client, processor, docs); diags.HasError() {
+			return diags
+		}
+	}
+
 	processorJson, err := json.MarshalIndent(map[string]*models.ProcessorScript{"script": processor}, "", " ")
 	if err != nil {
 		diag.FromErr(err)
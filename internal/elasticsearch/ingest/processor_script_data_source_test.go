@@ -1,6 +1,7 @@
 package ingest_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
@@ -22,6 +23,56 @@ func TestAccDataSourceIngestProcessorScript(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceIngestProcessorScriptValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIngestProcessorScriptValid,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_ingest_processor_script.test", "json"),
+				),
+			},
+			{
+				Config:      testAccDataSourceIngestProcessorScriptInvalid,
+				ExpectError: regexp.MustCompile(`Line 2, column \d+`),
+			},
+		},
+	})
+}
+
+const testAccDataSourceIngestProcessorScriptValid = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_script" "test" {
+  source = "ctx['tags'] = ctx['env'].splitOnToken('-');"
+
+  validation {
+    documents = [jsonencode({ env = "prod-eu" })]
+  }
+}
+`
+
+const testAccDataSourceIngestProcessorScriptInvalid = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_script" "test" {
+  source = <<EOF
+String env = ctx['env'];
+ctx['tags'] = envs.splitOnToken('-');
+EOF
+
+  validation {
+    documents = [jsonencode({ env = "prod-eu" })]
+  }
+}
+`
+
 const expectedJsonScript = `{
 	"script": {
 		"description": "Extract 'tags' from 'env' field",
@@ -0,0 +1,685 @@
+package ml
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	jobStateOpened = "opened"
+	jobStateClosed = "closed"
+)
+
+func ResourceAnomalyDetectionJob() *schema.Resource {
+	jobSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"job_id": {
+			Description: "Identifier for the anomaly detection job. It can contain lowercase alphanumeric characters (a-z and 0-9), hyphens, and underscores. It must start and end with alphanumeric characters.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 64),
+				validation.StringMatch(regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]*[a-z0-9])?$`), "must contain lowercase alphanumeric characters (a-z and 0-9), hyphens, and underscores, and must start and end with alphanumeric characters"),
+			),
+		},
+		"description": {
+			Description: "A description of the job.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"groups": {
+			Description: "A list of job groups. A job can belong to no groups or many.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"analysis_config": {
+			Description: "Specifies how to analyze the data. Changing this block forces a new job to be created.",
+			Type:        schema.TypeList,
+			Required:    true,
+			ForceNew:    true,
+			MinItems:    1,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"bucket_span": {
+						Description: "The size of the interval that the analysis is aggregated into, e.g. `15m`.",
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    true,
+					},
+					"detectors": {
+						Description: "Detector configuration objects. Detectors identify the anomaly detection functions and the fields on which they operate.",
+						Type:        schema.TypeList,
+						Required:    true,
+						ForceNew:    true,
+						MinItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"function": {
+									Description: "The analysis function that is used, e.g. `count`, `rare`, `mean`, `min`, `max` or `sum`.",
+									Type:        schema.TypeString,
+									Required:    true,
+									ForceNew:    true,
+								},
+								"field_name": {
+									Description: "The field that the detector uses in the function.",
+									Type:        schema.TypeString,
+									Optional:    true,
+									ForceNew:    true,
+								},
+								"by_field_name": {
+									Description: "The field used to split the data.",
+									Type:        schema.TypeString,
+									Optional:    true,
+									ForceNew:    true,
+								},
+								"over_field_name": {
+									Description: "The field used to split the data for population analysis.",
+									Type:        schema.TypeString,
+									Optional:    true,
+									ForceNew:    true,
+								},
+								"partition_field_name": {
+									Description: "The field used to segment the analysis.",
+									Type:        schema.TypeString,
+									Optional:    true,
+									ForceNew:    true,
+								},
+								"detector_description": {
+									Description: "A description of the detector. Generated by Elasticsearch when not set.",
+									Type:        schema.TypeString,
+									Optional:    true,
+									Computed:    true,
+									ForceNew:    true,
+								},
+								"exclude_frequent": {
+									Description:  "Whether frequent values are excluded from the results. One of `all`, `none`, `by` or `over`.",
+									Type:         schema.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringInSlice([]string{"all", "none", "by", "over"}, false),
+								},
+								"use_null": {
+									Description: "Whether a new series is used as the null series when there is no value for the by or partition fields.",
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     false,
+									ForceNew:    true,
+								},
+							},
+						},
+					},
+					"influencers": {
+						Description: "A list of influencer field names.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						ForceNew:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"summary_count_field_name": {
+						Description: "The field that contains the count of raw data points that have been summarized, if the input data is pre-summarized.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						ForceNew:    true,
+					},
+					"categorization_field_name": {
+						Description: "The field used to categorize unstructured text.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						ForceNew:    true,
+					},
+					"latency": {
+						Description: "The size of the window in which to expect data that is out of time order.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						ForceNew:    true,
+					},
+				},
+			},
+		},
+		"analysis_limits": {
+			Description: "Limits that can be applied for the resources required to hold the mathematical models in memory.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"model_memory_limit": {
+						Description:      "The approximate maximum amount of memory resources that are required for analytical processing, e.g. `1024mb`. The job is closed while this limit is updated.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						Computed:         true,
+						DiffSuppressFunc: diffByteSizeSuppress,
+					},
+					"categorization_examples_limit": {
+						Description: "The maximum number of examples stored per category in memory and in the results data store. Changing this forces a new job to be created.",
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						ForceNew:    true,
+					},
+				},
+			},
+		},
+		"data_description": {
+			Description: "Describes the format of the input data. Changing this block forces a new job to be created.",
+			Type:        schema.TypeList,
+			Required:    true,
+			ForceNew:    true,
+			MinItems:    1,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"time_field": {
+						Description: "The name of the field that contains the timestamp.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "time",
+						ForceNew:    true,
+					},
+					"time_format": {
+						Description: "The time format, which can be `epoch`, `epoch_ms`, or a custom pattern.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "epoch_ms",
+						ForceNew:    true,
+					},
+				},
+			},
+		},
+		"model_plot_config": {
+			Description: "Configures model plot, which stores model information along with the results.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Description: "If true, enables calculation and storage of the model bounds for each entity that is being analyzed.",
+						Type:        schema.TypeBool,
+						Required:    true,
+					},
+					"annotations_enabled": {
+						Description: "If true, enables calculation and storage of the model change annotations for each entity that is being analyzed.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+					"terms": {
+						Description: "Limits data collection to this comma separated list of partition or by field values.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"allow_lazy_open": {
+			Description: "Whether the job can be opened when there is insufficient machine learning node capacity for it to be immediately assigned to a node.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"background_persist_interval": {
+			Description: "The time between each periodic persistence of the model. It can't be unset, removing it keeps the current value.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"custom_settings": {
+			Description:      "Advanced configuration option, contains custom meta data about the job.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"daily_model_snapshot_retention_after_days": {
+			Description: "The period after which only one model snapshot per day is retained.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"model_snapshot_retention_days": {
+			Description: "The time in days that model snapshots are retained.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"renormalization_window_days": {
+			Description: "The period over which adjustments to the score are applied, as new data is seen. It can't be unset, removing it keeps the current value.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"results_retention_days": {
+			Description: "The period of time in days that results are retained. It can't be unset, removing it keeps the current value.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"state": {
+			Description:  "The desired state of the job, either `opened` or `closed`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      jobStateClosed,
+			ValidateFunc: validation.StringInSlice([]string{jobStateOpened, jobStateClosed}, false),
+		},
+	}
+
+	utils.AddConnectionSchema(jobSchema)
+
+	return &schema.Resource{
+		Description: "Creates, updates, opens and closes an anomaly detection job. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/ml-put-job.html",
+
+		CreateContext: resourceAnomalyDetectionJobCreate,
+		UpdateContext: resourceAnomalyDetectionJobUpdate,
+		ReadContext:   resourceAnomalyDetectionJobRead,
+		DeleteContext: resourceAnomalyDetectionJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: jobSchema,
+	}
+}
+
+func resourceAnomalyDetectionJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	jobID := d.Get("job_id").(string)
+	id, diags := client.ID(ctx, jobID)
+	if diags.HasError() {
+		return diags
+	}
+
+	job, diags := expandAnomalyDetectionJob(d)
+	if diags.HasError() {
+		return diags
+	}
+	if diags := elasticsearch.PutAnomalyDetectionJob(ctx, client, job); diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if d.Get("state").(string) == jobStateOpened {
+		if diags := elasticsearch.OpenAnomalyDetectionJob(ctx, client, jobID); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceAnomalyDetectionJobRead(ctx, d, meta)
+}
+
+func resourceAnomalyDetectionJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	jobID := compId.ResourceId
+
+	oldState, newState := d.GetChange("state")
+	isOpen := oldState.(string) == jobStateOpened
+
+	if d.HasChanges("description", "groups", "analysis_limits", "model_plot_config", "allow_lazy_open", "background_persist_interval", "custom_settings", "daily_model_snapshot_retention_after_days", "model_snapshot_retention_days", "renormalization_window_days", "results_retention_days") {
+		// the model memory limit can only be changed while the job is closed
+		if isOpen && d.HasChange("analysis_limits.0.model_memory_limit") {
+			if diags := elasticsearch.CloseAnomalyDetectionJob(ctx, client, jobID); diags.HasError() {
+				return diags
+			}
+			isOpen = false
+		}
+
+		job, diags := expandAnomalyDetectionJob(d)
+		if diags.HasError() {
+			return diags
+		}
+		if diags := elasticsearch.UpdateAnomalyDetectionJob(ctx, client, job); diags.HasError() {
+			return diags
+		}
+	}
+
+	if shouldOpen := newState.(string) == jobStateOpened; shouldOpen != isOpen {
+		if shouldOpen {
+			if diags := elasticsearch.OpenAnomalyDetectionJob(ctx, client, jobID); diags.HasError() {
+				return diags
+			}
+		} else {
+			if diags := elasticsearch.CloseAnomalyDetectionJob(ctx, client, jobID); diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return resourceAnomalyDetectionJobRead(ctx, d, meta)
+}
+
+func expandAnomalyDetectionJob(d *schema.ResourceData) (*models.AnomalyDetectionJob, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	job := models.AnomalyDetectionJob{
+		JobID:                     d.Get("job_id").(string),
+		Description:               d.Get("description").(string),
+		BackgroundPersistInterval: d.Get("background_persist_interval").(string),
+	}
+
+	allowLazyOpen := d.Get("allow_lazy_open").(bool)
+	job.AllowLazyOpen = &allowLazyOpen
+
+	for _, g := range d.Get("groups").(*schema.Set).List() {
+		job.Groups = append(job.Groups, g.(string))
+	}
+
+	analysis := d.Get("analysis_config").([]interface{})[0].(map[string]interface{})
+	job.AnalysisConfig = &models.AnomalyDetectionAnalysis{
+		BucketSpan:              analysis["bucket_span"].(string),
+		SummaryCountFieldName:   analysis["summary_count_field_name"].(string),
+		CategorizationFieldName: analysis["categorization_field_name"].(string),
+		Latency:                 analysis["latency"].(string),
+	}
+	for _, i := range analysis["influencers"].([]interface{}) {
+		job.AnalysisConfig.Influencers = append(job.AnalysisConfig.Influencers, i.(string))
+	}
+	for _, v := range analysis["detectors"].([]interface{}) {
+		detector := v.(map[string]interface{})
+		useNull := detector["use_null"].(bool)
+		job.AnalysisConfig.Detectors = append(job.AnalysisConfig.Detectors, models.AnomalyDetectionDetector{
+			Function:            detector["function"].(string),
+			FieldName:           detector["field_name"].(string),
+			ByFieldName:         detector["by_field_name"].(string),
+			OverFieldName:       detector["over_field_name"].(string),
+			PartitionFieldName:  detector["partition_field_name"].(string),
+			DetectorDescription: detector["detector_description"].(string),
+			ExcludeFrequent:     detector["exclude_frequent"].(string),
+			UseNull:             &useNull,
+		})
+	}
+
+	if v, ok := d.GetOk("analysis_limits"); ok && v.([]interface{})[0] != nil {
+		limits := v.([]interface{})[0].(map[string]interface{})
+		job.AnalysisLimits = &models.AnomalyDetectionLimits{
+			ModelMemoryLimit: limits["model_memory_limit"].(string),
+		}
+		if l := limits["categorization_examples_limit"].(int); l > 0 {
+			job.AnalysisLimits.CategorizationExamplesLimit = &l
+		}
+	}
+
+	dataDescription := d.Get("data_description").([]interface{})[0].(map[string]interface{})
+	job.DataDescription = &models.AnomalyDetectionData{
+		TimeField:  dataDescription["time_field"].(string),
+		TimeFormat: dataDescription["time_format"].(string),
+	}
+
+	if v, ok := d.GetOk("model_plot_config"); ok && v.([]interface{})[0] != nil {
+		modelPlot := v.([]interface{})[0].(map[string]interface{})
+		annotationsEnabled := modelPlot["annotations_enabled"].(bool)
+		job.ModelPlotConfig = &models.AnomalyDetectionModelPlot{
+			Enabled:            modelPlot["enabled"].(bool),
+			AnnotationsEnabled: &annotationsEnabled,
+			Terms:              modelPlot["terms"].(string),
+		}
+	} else if old, _ := d.GetChange("model_plot_config"); len(old.([]interface{})) > 0 {
+		// the model plot can't be removed from the job, only disabled
+		job.ModelPlotConfig = &models.AnomalyDetectionModelPlot{Enabled: false}
+	}
+
+	if v, ok := d.GetOk("custom_settings"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &job.CustomSettings); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	for key, field := range map[string]**int{
+		"daily_model_snapshot_retention_after_days": &job.DailyModelSnapshotRetentionAfterDays,
+		"model_snapshot_retention_days":             &job.ModelSnapshotRetentionDays,
+		"renormalization_window_days":               &job.RenormalizationWindowDays,
+		"results_retention_days":                    &job.ResultsRetentionDays,
+	} {
+		if v, ok := d.GetOk(key); ok {
+			i := v.(int)
+			*field = &i
+		}
+	}
+
+	return &job, diags
+}
+
+func resourceAnomalyDetectionJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	jobID := compId.ResourceId
+
+	job, diags := elasticsearch.GetAnomalyDetectionJob(ctx, client, jobID)
+	if job == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Anomaly detection job "%s" not found, removing from state`, jobID))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("job_id", jobID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", job.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("groups", job.Groups); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("background_persist_interval", job.BackgroundPersistInterval); err != nil {
+		return diag.FromErr(err)
+	}
+	if job.AllowLazyOpen != nil {
+		if err := d.Set("allow_lazy_open", *job.AllowLazyOpen); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if a := job.AnalysisConfig; a != nil {
+		detectors := make([]interface{}, len(a.Detectors))
+		for i, detector := range a.Detectors {
+			useNull := false
+			if detector.UseNull != nil {
+				useNull = *detector.UseNull
+			}
+			detectors[i] = map[string]interface{}{
+				"function":             detector.Function,
+				"field_name":           detector.FieldName,
+				"by_field_name":        detector.ByFieldName,
+				"over_field_name":      detector.OverFieldName,
+				"partition_field_name": detector.PartitionFieldName,
+				"detector_description": detector.DetectorDescription,
+				"exclude_frequent":     detector.ExcludeFrequent,
+				"use_null":             useNull,
+			}
+		}
+		analysis := map[string]interface{}{
+			"bucket_span":               a.BucketSpan,
+			"detectors":                 detectors,
+			"influencers":               a.Influencers,
+			"summary_count_field_name":  a.SummaryCountFieldName,
+			"categorization_field_name": a.CategorizationFieldName,
+			"latency":                   a.Latency,
+		}
+		if err := d.Set("analysis_config", []interface{}{analysis}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if l := job.AnalysisLimits; l != nil {
+		limits := map[string]interface{}{
+			"model_memory_limit": l.ModelMemoryLimit,
+		}
+		if l.CategorizationExamplesLimit != nil {
+			limits["categorization_examples_limit"] = *l.CategorizationExamplesLimit
+		}
+		if err := d.Set("analysis_limits", []interface{}{limits}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if dd := job.DataDescription; dd != nil {
+		dataDescription := map[string]interface{}{
+			"time_field":  dd.TimeField,
+			"time_format": dd.TimeFormat,
+		}
+		if err := d.Set("data_description", []interface{}{dataDescription}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	modelPlot := make([]interface{}, 0)
+	// the model plot disabled by the removal of the block is not reported
+	if _, configured := d.GetOk("model_plot_config"); job.ModelPlotConfig != nil && (job.ModelPlotConfig.Enabled || configured) {
+		mp := job.ModelPlotConfig
+		m := map[string]interface{}{
+			"enabled": mp.Enabled,
+			"terms":   mp.Terms,
+		}
+		if mp.AnnotationsEnabled != nil {
+			m["annotations_enabled"] = *mp.AnnotationsEnabled
+		}
+		modelPlot = append(modelPlot, m)
+	}
+	if err := d.Set("model_plot_config", modelPlot); err != nil {
+		return diag.FromErr(err)
+	}
+
+	customSettings := ""
+	if len(job.CustomSettings) > 0 {
+		customSettingsBytes, err := json.Marshal(job.CustomSettings)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		customSettings = string(customSettingsBytes)
+	}
+	if err := d.Set("custom_settings", customSettings); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for key, field := range map[string]*int{
+		"daily_model_snapshot_retention_after_days": job.DailyModelSnapshotRetentionAfterDays,
+		"model_snapshot_retention_days":             job.ModelSnapshotRetentionDays,
+		"renormalization_window_days":               job.RenormalizationWindowDays,
+		"results_retention_days":                    job.ResultsRetentionDays,
+	} {
+		if field != nil {
+			if err := d.Set(key, *field); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	stats, diags := elasticsearch.GetAnomalyDetectionJobStats(ctx, client, jobID)
+	if diags.HasError() {
+		return diags
+	}
+	if stats != nil {
+		if err := d.Set("state", normalizeJobState(stats.State)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceAnomalyDetectionJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeleteAnomalyDetectionJob(ctx, client, compId.ResourceId); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+// normalizeJobState maps the transitional job states to the state the job is moving to
+func normalizeJobState(state string) string {
+	switch state {
+	case "opening":
+		return jobStateOpened
+	case "closing":
+		return jobStateClosed
+	}
+	return state
+}
+
+var byteSizeRegexp = regexp.MustCompile(`^([0-9]+)(b|kb|mb|gb|tb|pb)?$`)
+
+var byteSizeUnits = map[string]int64{
+	"":   1 << 20, // a plain number is interpreted as megabytes
+	"b":  1,
+	"kb": 1 << 10,
+	"mb": 1 << 20,
+	"gb": 1 << 30,
+	"tb": 1 << 40,
+	"pb": 1 << 50,
+}
+
+func parseByteSize(v string) (int64, bool) {
+	matches := byteSizeRegexp.FindStringSubmatch(strings.ToLower(v))
+	if matches == nil {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n * byteSizeUnits[matches[2]], true
+}
+
+// diffByteSizeSuppress suppresses the diff when both values are the same size, e.g. `1gb` and `1024mb`
+func diffByteSizeSuppress(k, old, new string, d *schema.ResourceData) bool {
+	o, ok := parseByteSize(old)
+	if !ok {
+		return false
+	}
+	n, ok := parseByteSize(new)
+	if !ok {
+		return false
+	}
+	return o == n
+}
@@ -0,0 +1,182 @@
+package ml_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceAnomalyDetectionJob(t *testing.T) {
+	// generate a random name
+	jobID := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceAnomalyDetectionJobDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAnomalyDetectionJobCreate(jobID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "job_id", jobID),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "description", "test job"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "analysis_config.0.bucket_span", "15m"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "analysis_config.0.detectors.0.function", "count"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "data_description.0.time_field", "@timestamp"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "state", "closed"),
+				),
+			},
+			{
+				Config: testAccResourceAnomalyDetectionJobUpdate(jobID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "job_id", jobID),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "description", "updated test job"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "analysis_limits.0.model_memory_limit", "32mb"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "results_retention_days", "10"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "custom_settings", `{"owner":"terraform"}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "model_plot_config.0.enabled", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "state", "opened"),
+				),
+			},
+			{
+				Config: testAccResourceAnomalyDetectionJobRemoveFields(jobID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "description", ""),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "groups.#", "0"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "custom_settings", ""),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "model_plot_config.#", "0"),
+					// the results retention can't be unset, the current value is kept
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_anomaly_detection_job.test", "results_retention_days", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceAnomalyDetectionJobCreate(jobID string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_ml_anomaly_detection_job" "test" {
+  job_id      = "%s"
+  description = "test job"
+
+  analysis_config {
+    bucket_span = "15m"
+
+    detectors {
+      function = "count"
+    }
+  }
+
+  data_description {
+    time_field = "@timestamp"
+  }
+}
+	`, jobID)
+}
+
+func testAccResourceAnomalyDetectionJobUpdate(jobID string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_ml_anomaly_detection_job" "test" {
+  job_id      = "%s"
+  description = "updated test job"
+  groups      = ["test"]
+
+  analysis_config {
+    bucket_span = "15m"
+
+    detectors {
+      function = "count"
+    }
+  }
+
+  analysis_limits {
+    model_memory_limit = "32mb"
+  }
+
+  data_description {
+    time_field = "@timestamp"
+  }
+
+  model_plot_config {
+    enabled = true
+  }
+
+  custom_settings = jsonencode({
+    owner = "terraform"
+  })
+
+  results_retention_days = 10
+
+  state = "opened"
+}
+	`, jobID)
+}
+
+func testAccResourceAnomalyDetectionJobRemoveFields(jobID string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_ml_anomaly_detection_job" "test" {
+  job_id = "%s"
+
+  analysis_config {
+    bucket_span = "15m"
+
+    detectors {
+      function = "count"
+    }
+  }
+
+  analysis_limits {
+    model_memory_limit = "32mb"
+  }
+
+  data_description {
+    time_field = "@timestamp"
+  }
+
+  state = "opened"
+}
+	`, jobID)
+}
+
+func checkResourceAnomalyDetectionJobDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_ml_anomaly_detection_job" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+
+		job, diags := elasticsearch.GetAnomalyDetectionJob(context.Background(), client, compId.ResourceId)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get anomaly detection job %v", diags)
+		}
+		if job != nil {
+			return fmt.Errorf("Anomaly detection job (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
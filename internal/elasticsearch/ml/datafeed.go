@@ -0,0 +1,442 @@
+package ml
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	datafeedStateStarted = "started"
+	datafeedStateStopped = "stopped"
+)
+
+func ResourceDatafeed() *schema.Resource {
+	datafeedSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"datafeed_id": {
+			Description: "Identifier for the datafeed. It can contain lowercase alphanumeric characters (a-z and 0-9), hyphens, and underscores. It must start and end with alphanumeric characters.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 64),
+				validation.StringMatch(regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]*[a-z0-9])?$`), "must contain lowercase alphanumeric characters (a-z and 0-9), hyphens, and underscores, and must start and end with alphanumeric characters"),
+			),
+		},
+		"job_id": {
+			Description: "Identifier for the anomaly detection job the datafeed sends data to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"indices": {
+			Description: "The indices to retrieve data from. Wildcards are supported.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"query": {
+			Description:      "The Elasticsearch query domain-specific language (DSL) used to select the data. Defaults to a `match_all` query.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"aggregations": {
+			Description:      "If set, the datafeed performs aggregation searches. Removing the aggregations forces a new datafeed to be created.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"script_fields": {
+			Description:      "Script fields to be evaluated and passed to the job.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"runtime_mappings": {
+			Description:      "Definitions of search-time runtime fields that can be used by the datafeed.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"frequency": {
+			Description: "The interval at which scheduled queries are made while the datafeed runs in real time. Calculated by Elasticsearch when not set.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"query_delay": {
+			Description: "The number of seconds behind real time that data is queried. Calculated by Elasticsearch when not set.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"scroll_size": {
+			Description: "The size parameter that is used in Elasticsearch searches when the datafeed does not use aggregations.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"max_empty_searches": {
+			Description: "If a real-time datafeed has never seen any data, it is automatically stopped after this number of real-time searches that return no documents.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		"chunking_config": {
+			Description: "Datafeeds might be required to search over long time periods; this block configures how the searches are split into time chunks.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Description:  "The chunking mode, one of `auto`, `manual` or `off`.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"auto", "manual", "off"}, false),
+					},
+					"time_span": {
+						Description: "The time span that each search queries, only used when the mode is `manual`.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"delayed_data_check_config": {
+			Description: "Specifies whether the datafeed checks for missing data and the size of the window.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Description: "Specifies whether the datafeed periodically checks for delayed data.",
+						Type:        schema.TypeBool,
+						Required:    true,
+					},
+					"check_window": {
+						Description: "The window of time that is searched for late data.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"state": {
+			Description:  "The desired state of the datafeed, either `started` or `stopped`. The job of the datafeed must be opened to start it.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      datafeedStateStopped,
+			ValidateFunc: validation.StringInSlice([]string{datafeedStateStarted, datafeedStateStopped}, false),
+		},
+	}
+
+	utils.AddConnectionSchema(datafeedSchema)
+
+	return &schema.Resource{
+		Description: "Creates, updates, starts and stops a datafeed for an anomaly detection job. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/ml-put-datafeed.html",
+
+		CreateContext: resourceDatafeedCreate,
+		UpdateContext: resourceDatafeedUpdate,
+		ReadContext:   resourceDatafeedRead,
+		DeleteContext: resourceDatafeedDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: datafeedSchema,
+
+		// the aggregations can be updated but not removed from a datafeed
+		CustomizeDiff: customdiff.ForceNewIfChange("aggregations", func(ctx context.Context, old, new, meta interface{}) bool {
+			return old.(string) != "" && new.(string) == ""
+		}),
+	}
+}
+
+func resourceDatafeedCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	datafeedID := d.Get("datafeed_id").(string)
+	id, diags := client.ID(ctx, datafeedID)
+	if diags.HasError() {
+		return diags
+	}
+
+	datafeed, diags := expandDatafeed(d)
+	if diags.HasError() {
+		return diags
+	}
+	if diags := elasticsearch.PutDatafeed(ctx, client, datafeed); diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if d.Get("state").(string) == datafeedStateStarted {
+		if diags := elasticsearch.StartDatafeed(ctx, client, datafeedID); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDatafeedRead(ctx, d, meta)
+}
+
+func resourceDatafeedUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	datafeedID := compId.ResourceId
+
+	oldState, newState := d.GetChange("state")
+	isStarted := oldState.(string) == datafeedStateStarted
+
+	if d.HasChanges("indices", "query", "aggregations", "script_fields", "runtime_mappings", "frequency", "query_delay", "scroll_size", "max_empty_searches", "chunking_config", "delayed_data_check_config") {
+		// changes are only picked up by a stopped datafeed
+		if isStarted {
+			if diags := elasticsearch.StopDatafeed(ctx, client, datafeedID); diags.HasError() {
+				return diags
+			}
+			isStarted = false
+		}
+
+		datafeed, diags := expandDatafeed(d)
+		if diags.HasError() {
+			return diags
+		}
+		if diags := elasticsearch.UpdateDatafeed(ctx, client, datafeed); diags.HasError() {
+			return diags
+		}
+	}
+
+	if shouldStart := newState.(string) == datafeedStateStarted; shouldStart != isStarted {
+		if shouldStart {
+			if diags := elasticsearch.StartDatafeed(ctx, client, datafeedID); diags.HasError() {
+				return diags
+			}
+		} else {
+			if diags := elasticsearch.StopDatafeed(ctx, client, datafeedID); diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return resourceDatafeedRead(ctx, d, meta)
+}
+
+func expandDatafeed(d *schema.ResourceData) (*models.Datafeed, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	datafeed := models.Datafeed{
+		DatafeedID: d.Get("datafeed_id").(string),
+		JobID:      d.Get("job_id").(string),
+		Frequency:  d.Get("frequency").(string),
+		QueryDelay: d.Get("query_delay").(string),
+	}
+
+	for _, i := range d.Get("indices").([]interface{}) {
+		datafeed.Indices = append(datafeed.Indices, i.(string))
+	}
+
+	fields := map[string]*map[string]interface{}{
+		"query":            &datafeed.Query,
+		"aggregations":     &datafeed.Aggregations,
+		"script_fields":    &datafeed.ScriptFields,
+		"runtime_mappings": &datafeed.RuntimeMappings,
+	}
+	for key, field := range fields {
+		if v, ok := d.GetOk(key); ok {
+			if err := json.Unmarshal([]byte(v.(string)), field); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("scroll_size"); ok {
+		scrollSize := v.(int)
+		datafeed.ScrollSize = &scrollSize
+	}
+	if v, ok := d.GetOk("max_empty_searches"); ok {
+		maxEmptySearches := v.(int)
+		datafeed.MaxEmptySearches = &maxEmptySearches
+	}
+
+	if v, ok := d.GetOk("chunking_config"); ok && v.([]interface{})[0] != nil {
+		chunking := v.([]interface{})[0].(map[string]interface{})
+		datafeed.ChunkingConfig = &models.DatafeedChunking{
+			Mode:     chunking["mode"].(string),
+			TimeSpan: chunking["time_span"].(string),
+		}
+	}
+	if v, ok := d.GetOk("delayed_data_check_config"); ok && v.([]interface{})[0] != nil {
+		check := v.([]interface{})[0].(map[string]interface{})
+		datafeed.DelayedDataCheckConfig = &models.DatafeedDelayedDataCheck{
+			Enabled:     check["enabled"].(bool),
+			CheckWindow: check["check_window"].(string),
+		}
+	}
+
+	return &datafeed, diags
+}
+
+func resourceDatafeedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	datafeedID := compId.ResourceId
+
+	datafeed, diags := elasticsearch.GetDatafeed(ctx, client, datafeedID)
+	if datafeed == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Datafeed "%s" not found, removing from state`, datafeedID))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("datafeed_id", datafeedID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("job_id", datafeed.JobID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("indices", datafeed.Indices); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("frequency", datafeed.Frequency); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("query_delay", datafeed.QueryDelay); err != nil {
+		return diag.FromErr(err)
+	}
+	if datafeed.ScrollSize != nil {
+		if err := d.Set("scroll_size", *datafeed.ScrollSize); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if datafeed.MaxEmptySearches != nil {
+		if err := d.Set("max_empty_searches", *datafeed.MaxEmptySearches); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	fields := map[string]map[string]interface{}{
+		"query":            datafeed.Query,
+		"aggregations":     datafeed.Aggregations,
+		"script_fields":    datafeed.ScriptFields,
+		"runtime_mappings": datafeed.RuntimeMappings,
+	}
+	for key, field := range fields {
+		// the query is always returned, the fields reset by an update may be returned empty
+		if key == "query" && field == nil {
+			continue
+		}
+		v := ""
+		if len(field) > 0 {
+			fieldBytes, err := json.Marshal(field)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			v = string(fieldBytes)
+		}
+		if err := d.Set(key, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if c := datafeed.ChunkingConfig; c != nil {
+		chunking := map[string]interface{}{
+			"mode":      c.Mode,
+			"time_span": c.TimeSpan,
+		}
+		if err := d.Set("chunking_config", []interface{}{chunking}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if c := datafeed.DelayedDataCheckConfig; c != nil {
+		check := map[string]interface{}{
+			"enabled":      c.Enabled,
+			"check_window": c.CheckWindow,
+		}
+		if err := d.Set("delayed_data_check_config", []interface{}{check}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	stats, diags := elasticsearch.GetDatafeedStats(ctx, client, datafeedID)
+	if diags.HasError() {
+		return diags
+	}
+	if stats != nil {
+		if err := d.Set("state", normalizeDatafeedState(stats.State)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceDatafeedDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeleteDatafeed(ctx, client, compId.ResourceId); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+// normalizeDatafeedState maps the transitional datafeed states to the state the datafeed is moving to
+func normalizeDatafeedState(state string) string {
+	switch state {
+	case "starting":
+		return datafeedStateStarted
+	case "stopping":
+		return datafeedStateStopped
+	}
+	return state
+}
@@ -0,0 +1,327 @@
+package ml_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceDatafeed(t *testing.T) {
+	// generate a random name
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceDatafeedDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDatafeedCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "datafeed_id", fmt.Sprintf("datafeed-%s", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "job_id", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "indices.0", fmt.Sprintf("%s-source", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "state", "stopped"),
+				),
+			},
+			{
+				Config: testAccResourceDatafeedUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "datafeed_id", fmt.Sprintf("datafeed-%s", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "scroll_size", "500"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "query", `{"term":{"status":"error"}}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "script_fields", `{"status_length":{"script":{"source":"doc['status'].value.length()"}}}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "runtime_mappings", `{"status_upper":{"script":{"source":"emit(doc['status'].value.toUpperCase())"},"type":"keyword"}}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "state", "started"),
+				),
+			},
+			{
+				Config: testAccResourceDatafeedRemoveFields(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "script_fields", ""),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "runtime_mappings", ""),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "state", "started"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDatafeedRemoveAggregations(t *testing.T) {
+	// generate a random name
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceDatafeedDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDatafeedAggregations(name, true),
+				Check:  resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_ml_datafeed.test", "aggregations"),
+			},
+			{
+				// the datafeed is replaced, as the aggregations can't be removed by an update
+				Config: testAccResourceDatafeedAggregations(name, false),
+				Check:  resource.TestCheckResourceAttr("elasticstack_elasticsearch_ml_datafeed.test", "aggregations", ""),
+			},
+		},
+	})
+}
+
+func testAccResourceDatafeedCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "source" {
+  name = "%s-source"
+
+  mappings = jsonencode({
+    properties = {
+      "@timestamp" = { type = "date" }
+      status       = { type = "keyword" }
+    }
+  })
+}
+
+resource "elasticstack_elasticsearch_ml_anomaly_detection_job" "test" {
+  job_id = "%s"
+
+  analysis_config {
+    bucket_span = "15m"
+
+    detectors {
+      function = "count"
+    }
+  }
+
+  data_description {
+    time_field = "@timestamp"
+  }
+
+  state = "opened"
+}
+
+resource "elasticstack_elasticsearch_ml_datafeed" "test" {
+  datafeed_id = "datafeed-%s"
+  job_id      = elasticstack_elasticsearch_ml_anomaly_detection_job.test.job_id
+  indices     = [elasticstack_elasticsearch_index.source.name]
+}
+	`, name, name, name)
+}
+
+func testAccResourceDatafeedUpdate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "source" {
+  name = "%s-source"
+
+  mappings = jsonencode({
+    properties = {
+      "@timestamp" = { type = "date" }
+      status       = { type = "keyword" }
+    }
+  })
+}
+
+resource "elasticstack_elasticsearch_ml_anomaly_detection_job" "test" {
+  job_id = "%s"
+
+  analysis_config {
+    bucket_span = "15m"
+
+    detectors {
+      function = "count"
+    }
+  }
+
+  data_description {
+    time_field = "@timestamp"
+  }
+
+  state = "opened"
+}
+
+resource "elasticstack_elasticsearch_ml_datafeed" "test" {
+  datafeed_id = "datafeed-%s"
+  job_id      = elasticstack_elasticsearch_ml_anomaly_detection_job.test.job_id
+  indices     = [elasticstack_elasticsearch_index.source.name]
+
+  query = jsonencode({
+    term = {
+      status = "error"
+    }
+  })
+
+  script_fields = jsonencode({
+    status_length = {
+      script = {
+        source = "doc['status'].value.length()"
+      }
+    }
+  })
+
+  runtime_mappings = jsonencode({
+    status_upper = {
+      type = "keyword"
+      script = {
+        source = "emit(doc['status'].value.toUpperCase())"
+      }
+    }
+  })
+
+  scroll_size = 500
+
+  state = "started"
+}
+	`, name, name, name)
+}
+
+func testAccResourceDatafeedRemoveFields(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "source" {
+  name = "%s-source"
+
+  mappings = jsonencode({
+    properties = {
+      "@timestamp" = { type = "date" }
+      status       = { type = "keyword" }
+    }
+  })
+}
+
+resource "elasticstack_elasticsearch_ml_anomaly_detection_job" "test" {
+  job_id = "%s"
+
+  analysis_config {
+    bucket_span = "15m"
+
+    detectors {
+      function = "count"
+    }
+  }
+
+  data_description {
+    time_field = "@timestamp"
+  }
+
+  state = "opened"
+}
+
+resource "elasticstack_elasticsearch_ml_datafeed" "test" {
+  datafeed_id = "datafeed-%s"
+  job_id      = elasticstack_elasticsearch_ml_anomaly_detection_job.test.job_id
+  indices     = [elasticstack_elasticsearch_index.source.name]
+
+  query = jsonencode({
+    term = {
+      status = "error"
+    }
+  })
+
+  scroll_size = 500
+
+  state = "started"
+}
+	`, name, name, name)
+}
+
+func testAccResourceDatafeedAggregations(name string, withAggregations bool) string {
+	aggregations := ""
+	if withAggregations {
+		aggregations = `
+  aggregations = jsonencode({
+    buckets = {
+      date_histogram = {
+        field          = "@timestamp"
+        fixed_interval = "15m"
+      }
+      aggregations = {
+        "@timestamp" = {
+          max = {
+            field = "@timestamp"
+          }
+        }
+      }
+    }
+  })`
+	}
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "source" {
+  name = "%[1]s-source"
+
+  mappings = jsonencode({
+    properties = {
+      "@timestamp" = { type = "date" }
+    }
+  })
+}
+
+resource "elasticstack_elasticsearch_ml_anomaly_detection_job" "test" {
+  job_id = "%[1]s"
+
+  analysis_config {
+    bucket_span              = "15m"
+    summary_count_field_name = "doc_count"
+
+    detectors {
+      function = "count"
+    }
+  }
+
+  data_description {
+    time_field = "@timestamp"
+  }
+}
+
+resource "elasticstack_elasticsearch_ml_datafeed" "test" {
+  datafeed_id = "datafeed-%[1]s"
+  job_id      = elasticstack_elasticsearch_ml_anomaly_detection_job.test.job_id
+  indices     = [elasticstack_elasticsearch_index.source.name]
+%[2]s
+}
+	`, name, aggregations)
+}
+
+func checkResourceDatafeedDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_ml_datafeed" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+
+		datafeed, diags := elasticsearch.GetDatafeed(context.Background(), client, compId.ResourceId)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get datafeed %v", diags)
+		}
+		if datafeed != nil {
+			return fmt.Errorf("Datafeed (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
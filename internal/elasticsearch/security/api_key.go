@@ -3,20 +3,24 @@ package security
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
 	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var APIKeyMinVersion = version.Must(version.NewVersion("8.0.0")) // Enabled in 8.0
+var APIKeyMinVersion = version.Must(version.NewVersion("8.0.0"))       // Enabled in 8.0
+var APIKeyUpdateMinVersion = version.Must(version.NewVersion("8.4.0")) // Update API key added in 8.4
 
 func ResourceApiKey() *schema.Resource {
 	apikeySchema := map[string]*schema.Schema{
@@ -36,10 +40,9 @@ func ResourceApiKey() *schema.Resource {
 			),
 		},
 		"role_descriptors": {
-			Description:      "Role descriptors for this API key.",
+			Description:      "Role descriptors for this API key. Updated in place from Elasticsearch 8.4, the key is replaced on older versions.",
 			Type:             schema.TypeString,
 			Optional:         true,
-			ForceNew:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
 		},
@@ -55,14 +58,68 @@ func ResourceApiKey() *schema.Resource {
 			Computed:    true,
 		},
 		"metadata": {
-			Description:      "Arbitrary metadata that you want to associate with the API key.",
+			Description:      "Arbitrary metadata that you want to associate with the API key. Updated in place from Elasticsearch 8.4, the key is replaced on older versions.",
 			Type:             schema.TypeString,
 			Optional:         true,
 			Computed:         true,
-			ForceNew:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
 		},
+		"grant": {
+			Description: "Creates the API key on behalf of another user, who owns the key, with the grant API key API. Requires the `grant_api_key` cluster privilege. Changes to `role_descriptors` and `metadata` replace granted keys.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"grant_type": {
+						Description:  "The type of grant, either `password` or `access_token`.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice([]string{"password", "access_token"}, false),
+					},
+					"username": {
+						Description: "The user name of the user the key is created for. Required by the `password` grant.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						ForceNew:    true,
+					},
+					"password": {
+						Description: "The password of the user. Required by the `password` grant.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+						ForceNew:    true,
+					},
+					"access_token": {
+						Description: "An access token of the user, e.g. obtained from the get token API. Required by the `access_token` grant.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+						ForceNew:    true,
+					},
+					"run_as": {
+						Description: "The name of the user to impersonate.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						ForceNew:    true,
+					},
+				},
+			},
+		},
+		"rotation_period": {
+			Description:  "Period after which the API key is replaced by a new one, e.g. `720h`. The rotation is planned by the first plan after the period has elapsed, and applied in place: the old key is invalidated once the new one has been created.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: utils.StringIsDuration,
+		},
+		"creation_timestamp": {
+			Description: "Creation time in milliseconds of the API key.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
 		"api_key": {
 			Description: "Generated API Key.",
 			Type:        schema.TypeString,
@@ -87,6 +144,8 @@ func ResourceApiKey() *schema.Resource {
 		ReadContext:   resourceSecurityApiKeyRead,
 		DeleteContext: resourceSecurityApiKeyDelete,
 
+		CustomizeDiff: resourceSecurityApiKeyCustomizeDiff,
+
 		Schema: apikeySchema,
 	}
 }
@@ -97,10 +156,67 @@ func resourceSecurityApiKeyCreate(ctx context.Context, d *schema.ResourceData, m
 		return diags
 	}
 
-	nameId := d.Get("name").(string)
+	if diags := putApiKey(ctx, client, d); diags.HasError() {
+		return diags
+	}
+	return resourceSecurityApiKeyRead(ctx, d, meta)
+}
+
+// putApiKey creates a new API key from the configuration, and points the resource to it
+func putApiKey(ctx context.Context, client *clients.ApiClient, d *schema.ResourceData) diag.Diagnostics {
+	apikey, diags := expandApiKey(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	var putResponse *models.ApiKeyResponse
+	if v, ok := d.GetOk("grant"); ok {
+		grant, diags := expandApiKeyGrant(v.([]interface{})[0].(map[string]interface{}))
+		if diags.HasError() {
+			return diags
+		}
+		grant.ApiKey = *apikey
+		putResponse, diags = elasticsearch.GrantApiKey(ctx, client, grant)
+		if diags.HasError() {
+			return diags
+		}
+	} else {
+		putResponse, diags = elasticsearch.PutApiKey(ctx, client, apikey)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	id, diags := client.ID(ctx, putResponse.Id)
+	if diags.HasError() {
+		return diags
+	}
+
+	if putResponse.Key != "" {
+		if err := d.Set("api_key", putResponse.Key); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if putResponse.EncodedKey != "" {
+		if err := d.Set("encoded", putResponse.EncodedKey); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("expiration_timestamp", putResponse.Expiration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("expiration", apikey.Expiration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return nil
+}
 
+func expandApiKey(d *schema.ResourceData) (*models.ApiKey, diag.Diagnostics) {
 	var apikey models.ApiKey
-	apikey.Name = nameId
+	apikey.Name = d.Get("name").(string)
 
 	if v, ok := d.GetOk("expiration"); ok {
 		apikey.Expiration = v.(string)
@@ -109,7 +225,7 @@ func resourceSecurityApiKeyCreate(ctx context.Context, d *schema.ResourceData, m
 	if v, ok := d.GetOk("role_descriptors"); ok {
 		role_descriptors := map[string]models.Role{}
 		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&role_descriptors); err != nil {
-			return diag.FromErr(err)
+			return nil, diag.FromErr(err)
 		}
 		apikey.RolesDescriptors = role_descriptors
 	}
@@ -117,52 +233,125 @@ func resourceSecurityApiKeyCreate(ctx context.Context, d *schema.ResourceData, m
 	if v, ok := d.GetOk("metadata"); ok {
 		metadata := make(map[string]interface{})
 		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
-			return diag.FromErr(err)
+			return nil, diag.FromErr(err)
 		}
 		apikey.Metadata = metadata
 	}
+	return &apikey, nil
+}
 
-	putResponse, diags := elasticsearch.PutApiKey(client, &apikey)
+func expandApiKeyGrant(g map[string]interface{}) (*models.ApiKeyGrant, diag.Diagnostics) {
+	grant := models.ApiKeyGrant{
+		GrantType:   g["grant_type"].(string),
+		Username:    g["username"].(string),
+		Password:    g["password"].(string),
+		AccessToken: g["access_token"].(string),
+		RunAs:       g["run_as"].(string),
+	}
+	switch grant.GrantType {
+	case "password":
+		if grant.Username == "" || grant.Password == "" {
+			return nil, diag.Errorf(`"username" and "password" are required by the "password" grant`)
+		}
+	case "access_token":
+		if grant.AccessToken == "" {
+			return nil, diag.Errorf(`"access_token" is required by the "access_token" grant`)
+		}
+	}
+	return &grant, nil
+}
 
+func resourceSecurityApiKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
 		return diags
 	}
-
-	id, diags := client.ID(ctx, putResponse.Id)
+	compId, diags := clients.CompositeIdFromStr(d.Id())
 	if diags.HasError() {
 		return diags
 	}
 
-	if putResponse.Key != "" {
-		if err := d.Set("api_key", putResponse.Key); err != nil {
-			return diag.FromErr(err)
+	// a new creation time is planned by the rotation, see resourceSecurityApiKeyCustomizeDiff
+	if d.HasChange("creation_timestamp") {
+		// the old key is only invalidated once the new one exists, the new key is created from the current configuration
+		if diags := putApiKey(ctx, client, d); diags.HasError() {
+			return diags
 		}
-	}
-	if putResponse.EncodedKey != "" {
-		if err := d.Set("encoded", putResponse.EncodedKey); err != nil {
-			return diag.FromErr(err)
+		if diags := elasticsearch.DeleteApiKey(ctx, client, compId.ResourceId); diags.HasError() {
+			return diags
 		}
-	}
-	if err := d.Set("expiration_timestamp", putResponse.Expiration); err != nil {
-		return diag.FromErr(err)
+		return resourceSecurityApiKeyRead(ctx, d, meta)
 	}
 
-	if err := d.Set("expiration", apikey.Expiration); err != nil {
-		return diag.FromErr(err)
+	// the plan replaces the key on the versions which cannot update it, see resourceSecurityApiKeyCustomizeDiff
+	if d.HasChanges("role_descriptors", "metadata") {
+		apikey, diags := expandApiKey(d)
+		if diags.HasError() {
+			return diags
+		}
+		if diags := elasticsearch.UpdateApiKey(ctx, client, compId.ResourceId, apikey); diags.HasError() {
+			return diags
+		}
 	}
 
-	d.SetId(id.String())
 	return resourceSecurityApiKeyRead(ctx, d, meta)
 }
 
-func resourceSecurityApiKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	diags := diag.Diagnostics{diag.Diagnostic{
-		Severity: diag.Error,
-		Summary:  `Cannot update API Key`,
-		Detail:   `update not currently supported.`,
-	}}
+func resourceSecurityApiKeyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
 
-	return diags
+	// the creation time of the key is unknown until it has been read, e.g. when the refresh is skipped
+	if v, ok := d.GetOk("rotation_period"); ok && d.Get("creation_timestamp").(int) > 0 {
+		period, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return err
+		}
+		created := time.UnixMilli(int64(d.Get("creation_timestamp").(int)))
+		if time.Since(created) >= period {
+			// the key is rotated in place by the update, which creates the new key from the planned configuration
+			for _, k := range []string{"id", "creation_timestamp", "expiration_timestamp", "api_key", "encoded"} {
+				if err := d.SetNewComputed(k); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	if _, ok := d.GetOk("grant"); ok {
+		// only the owner of the key can update it, and we act on behalf of the owner only when creating the key
+		for _, k := range []string{"role_descriptors", "metadata"} {
+			if d.HasChange(k) {
+				if err := d.ForceNew(k); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if d.HasChanges("role_descriptors", "metadata") {
+		client, diags := clients.NewApiClientFromDiff(d, meta)
+		if diags.HasError() {
+			return utils.DiagsAsError(diags)
+		}
+		serverVersion, diags := client.ServerVersion(ctx)
+		if diags.HasError() {
+			return utils.DiagsAsError(diags)
+		}
+		if serverVersion.LessThan(APIKeyUpdateMinVersion) {
+			for _, k := range []string{"role_descriptors", "metadata"} {
+				if d.HasChange(k) {
+					if err := d.ForceNew(k); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
 }
 
 func resourceSecurityApiKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -176,8 +365,9 @@ func resourceSecurityApiKeyRead(ctx context.Context, d *schema.ResourceData, met
 	}
 	id := compId.ResourceId
 
-	apikey, diags := elasticsearch.GetApiKey(client, id)
+	apikey, diags := elasticsearch.GetApiKey(ctx, client, id)
 	if apikey == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`API key "%s" not found, removing from state`, compId.ResourceId))
 		d.SetId("")
 		return diags
 	}
@@ -197,6 +387,9 @@ func resourceSecurityApiKeyRead(ctx context.Context, d *schema.ResourceData, met
 	if err := d.Set("expiration_timestamp", apikey.Expiration); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("creation_timestamp", apikey.Creation); err != nil {
+		return diag.FromErr(err)
+	}
 
 	if apikey.RolesDescriptors != nil {
 		rolesDescriptors, err := json.Marshal(apikey.RolesDescriptors)
@@ -225,7 +418,7 @@ func resourceSecurityApiKeyDelete(ctx context.Context, d *schema.ResourceData, m
 		return diags
 	}
 
-	if diags := elasticsearch.DeleteApiKey(client, compId.ResourceId); diags.HasError() {
+	if diags := elasticsearch.DeleteApiKey(ctx, client, compId.ResourceId); diags.HasError() {
 		return diags
 	}
 
@@ -1,10 +1,13 @@
 package security_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
@@ -64,6 +67,178 @@ func TestAccResourceSecuritApiKey(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecuritApiKeyUpdate(t *testing.T) {
+	apiKeyName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	var apiKeyId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityApiKeyDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyUpdateMinVersion),
+				Config:   testAccResourceSecuritApiKeyUpdate(apiKeyName, "index-a*", "a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "metadata", `{"env":"a"}`),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "creation_timestamp"),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "id", func(value string) error {
+						apiKeyId = value
+						return nil
+					}),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyUpdateMinVersion),
+				Config:   testAccResourceSecuritApiKeyUpdate(apiKeyName, "index-b*", "b"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "metadata", `{"env":"b"}`),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "role_descriptors", func(value string) error {
+						if !strings.Contains(value, "index-b*") {
+							return fmt.Errorf("role descriptors %s have not been updated", value)
+						}
+						return nil
+					}),
+					// the key has been updated in place
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "id", func(value string) error {
+						if value != apiKeyId {
+							return fmt.Errorf("API key has been replaced: %s != %s", value, apiKeyId)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecuritApiKeyRotation(t *testing.T) {
+	apiKeyName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	var apiKeyId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityApiKeyDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyMinVersion),
+				Config:   testAccResourceSecuritApiKeyRotation(apiKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "rotation_period", "20s"),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "id", func(value string) error {
+						apiKeyId = value
+						return nil
+					}),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyMinVersion),
+				PreConfig: func() {
+					time.Sleep(20 * time.Second)
+				},
+				Config: testAccResourceSecuritApiKeyRotation(apiKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "id", func(value string) error {
+						if value == apiKeyId {
+							return fmt.Errorf("API key %s has not been rotated", value)
+						}
+						return nil
+					}),
+					func(s *terraform.State) error {
+						return checkApiKeyInvalidated(apiKeyId)
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecuritApiKeyGrant(t *testing.T) {
+	apiKeyName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityApiKeyDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyMinVersion),
+				Config:   testAccResourceSecuritApiKeyGrant(apiKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "name", apiKeyName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "grant.0.grant_type", "password"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "encoded"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSecuritApiKeyGrant(apiKeyName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_user" "owner" {
+  username = "%s"
+  password = "qwerty123"
+  roles    = ["viewer"]
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "test" {
+  name = "%s"
+
+  grant {
+    grant_type = "password"
+    username   = elasticstack_elasticsearch_security_user.owner.username
+    password   = "qwerty123"
+  }
+
+  expiration = "1d"
+}
+	`, apiKeyName, apiKeyName)
+}
+
+func testAccResourceSecuritApiKeyUpdate(apiKeyName, index, env string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "test" {
+  name = "%s"
+
+  role_descriptors = jsonencode({
+    role-a = {
+      indices = [{
+        names      = ["%s"]
+        privileges = ["read"]
+      }]
+    }
+  })
+
+  metadata = jsonencode({
+    env = "%s"
+  })
+}
+	`, apiKeyName, index, env)
+}
+
+func testAccResourceSecuritApiKeyRotation(apiKeyName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "test" {
+  name            = "%s"
+  rotation_period = "20s"
+}
+	`, apiKeyName)
+}
+
 func testAccResourceSecuritApiKeyCreate(apiKeyName string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -96,19 +271,39 @@ func checkResourceSecurityApiKeyDestroy(s *terraform.State) error {
 	}
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "elasticstack_elasticsearch_security_api_key" {
+		if rs.Type != "elasticstack_elasticsearch_security_api_key" && rs.Type != "elasticstack_elasticsearch_security_cross_cluster_api_key" {
 			continue
 		}
 		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
 
-		apiKey, diags := elasticsearch.GetApiKey(client, compId.ResourceId)
+		apiKey, diags := elasticsearch.GetApiKey(context.Background(), client, compId.ResourceId)
 		if diags.HasError() {
 			return fmt.Errorf("Unabled to get API key %v", diags)
 		}
 
-		if !apiKey.Invalidated {
+		if apiKey != nil && !apiKey.Invalidated {
 			return fmt.Errorf("ApiKey (%s) has not been invalidated", compId.ResourceId)
 		}
 	}
 	return nil
 }
+
+func checkApiKeyInvalidated(id string) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+	compId, diags := clients.CompositeIdFromStr(id)
+	if diags.HasError() {
+		return fmt.Errorf("Unable to parse the id %s: %v", id, diags)
+	}
+
+	apiKey, diags := elasticsearch.GetApiKey(context.Background(), client, compId.ResourceId)
+	if diags.HasError() {
+		return fmt.Errorf("Unabled to get API key %v", diags)
+	}
+	if apiKey != nil && !apiKey.Invalidated {
+		return fmt.Errorf("ApiKey (%s) has not been invalidated", compId.ResourceId)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var APIKeyQueryMinVersion = version.Must(version.NewVersion("7.15.0")) // Query API key information added in 7.15
+
+func DataSourceApiKeys() *schema.Resource {
+	apiKeysSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Only returns the API keys with the given name. Wildcard (`*`) expressions are supported.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"owner": {
+			Description: "Only returns the API keys owned by the given user.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"realm": {
+			Description: "Only returns the API keys owned by users of the given realm.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"metadata": {
+			Description: "Only returns the API keys with the given metadata values.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"expires_within": {
+			Description:  "Only returns the API keys which expire within the given period from now, e.g. `168h`. The keys which have already expired are included.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: utils.StringIsDuration,
+		},
+		"include_invalidated": {
+			Description: "Whether to include the invalidated API keys.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"size": {
+			Description:  "The maximum number of API keys to return.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      100,
+			ValidateFunc: validation.IntBetween(1, 10000),
+		},
+		"api_keys": {
+			Description: "The matching API keys, the ones which expire first come first.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The identifier of the API key.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the API key.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"type": {
+						Description: "The type of the API key, e.g. `rest` or `cross_cluster`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"owner": {
+						Description: "The user who owns the API key.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"realm": {
+						Description: "The realm of the user who owns the API key.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"creation_timestamp": {
+						Description: "Creation time in milliseconds of the API key.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"expiration_timestamp": {
+						Description: "Expiration time in milliseconds of the API key, `0` when the key never expires.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"invalidated": {
+						Description: "Whether the API key has been invalidated.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"metadata": {
+						Description: "The metadata of the API key, as JSON.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(apiKeysSchema)
+
+	return &schema.Resource{
+		Description: "Searches the API keys of the cluster. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-query-api-key.html",
+
+		ReadContext: dataSourceSecurityApiKeysRead,
+
+		Schema: apiKeysSchema,
+	}
+}
+
+func dataSourceSecurityApiKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	if serverVersion.LessThan(APIKeyQueryMinVersion) {
+		return diag.Errorf("Querying API keys is supported only for Elasticsearch v%s and above", APIKeyQueryMinVersion.String())
+	}
+
+	params := models.QueryApiKeysParams{
+		Name:               d.Get("name").(string),
+		Owner:              d.Get("owner").(string),
+		Realm:              d.Get("realm").(string),
+		Metadata:           make(map[string]string),
+		IncludeInvalidated: d.Get("include_invalidated").(bool),
+		Size:               d.Get("size").(int),
+	}
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		params.Metadata[k] = v.(string)
+	}
+	if v, ok := d.GetOk("expires_within"); ok {
+		period, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		expiresBefore := time.Now().Add(period)
+		params.ExpiresBefore = &expiresBefore
+	}
+
+	id, diags := client.ID(ctx, fmt.Sprintf("api-keys/%s/%s/%s", params.Name, params.Owner, params.Realm))
+	if diags.HasError() {
+		return diags
+	}
+
+	apiKeys, diags := elasticsearch.QueryApiKeys(ctx, client, &params)
+	if diags.HasError() {
+		return diags
+	}
+
+	result := make([]interface{}, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		metadata, err := json.Marshal(apiKey.Metadata)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		result[i] = map[string]interface{}{
+			"id":                   apiKey.Id,
+			"name":                 apiKey.Name,
+			"type":                 apiKey.Type,
+			"owner":                apiKey.Username,
+			"realm":                apiKey.Realm,
+			"creation_timestamp":   apiKey.Creation,
+			"expiration_timestamp": apiKey.Expiration,
+			"invalidated":          apiKey.Invalidated,
+			"metadata":             string(metadata),
+		}
+	}
+	if err := d.Set("api_keys", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
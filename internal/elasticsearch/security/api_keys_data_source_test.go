@@ -0,0 +1,82 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/security"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityApiKeys(t *testing.T) {
+	apiKeyName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyQueryMinVersion),
+				Config:   testAccDataSourceSecurityApiKeys(apiKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_api_keys.expiring", "api_keys.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_api_keys.expiring", "api_keys.0.name", apiKeyName+"-expiring"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_api_keys.expiring", "api_keys.0.owner", "elastic"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_api_keys.expiring", "api_keys.0.invalidated", "false"),
+					resource.TestCheckResourceAttrPair("data.elasticstack_elasticsearch_security_api_keys.expiring", "api_keys.0.expiration_timestamp", "elasticstack_elasticsearch_security_api_key.expiring", "expiration_timestamp"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_api_keys.all", "api_keys.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_api_keys.all", "api_keys.0.name", apiKeyName+"-expiring"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_api_keys.all", "api_keys.1.name", apiKeyName+"-permanent"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecurityApiKeys(apiKeyName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "expiring" {
+  name       = "%[1]s-expiring"
+  expiration = "2d"
+  metadata = jsonencode({
+    "test" = "%[1]s"
+  })
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "permanent" {
+  name = "%[1]s-permanent"
+  metadata = jsonencode({
+    "test" = "%[1]s"
+  })
+}
+
+data "elasticstack_elasticsearch_security_api_keys" "expiring" {
+  owner          = "elastic"
+  expires_within = "168h"
+  metadata = {
+    test = "%[1]s"
+  }
+
+  depends_on = [
+    elasticstack_elasticsearch_security_api_key.expiring,
+    elasticstack_elasticsearch_security_api_key.permanent,
+  ]
+}
+
+data "elasticstack_elasticsearch_security_api_keys" "all" {
+  name = "%[1]s-*"
+
+  depends_on = [
+    elasticstack_elasticsearch_security_api_key.expiring,
+    elasticstack_elasticsearch_security_api_key.permanent,
+  ]
+}
+	`, apiKeyName)
+}
@@ -0,0 +1,182 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceApplicationPrivilege() *schema.Resource {
+	privilegeSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"application": {
+			Description:  "The name of the application to which the privilege belongs.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z][a-zA-Z0-9_-]*$`), "must begin with a lowercase ASCII letter and contain only ASCII letters, digits, `_` and `-`"),
+		},
+		"name": {
+			Description:  "The name of the privilege.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z][a-zA-Z0-9_.-]*$`), "must begin with a lowercase ASCII letter and contain only ASCII letters, digits, `_`, `-` and `.`"),
+		},
+		"actions": {
+			Description: "A list of the actions granted by the privilege, e.g. `data:read/*` or `action:login`.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[/*:]`), "must contain one of `/`, `*` or `:`"),
+			},
+		},
+		"metadata": {
+			Description:      "Optional meta-data.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+	}
+
+	utils.AddConnectionSchema(privilegeSchema)
+
+	return &schema.Resource{
+		Description: "Adds or updates application privileges. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-put-privileges.html",
+
+		CreateContext: resourceSecurityApplicationPrivilegePut,
+		UpdateContext: resourceSecurityApplicationPrivilegePut,
+		ReadContext:   resourceSecurityApplicationPrivilegeRead,
+		DeleteContext: resourceSecurityApplicationPrivilegeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: privilegeSchema,
+	}
+}
+
+func resourceSecurityApplicationPrivilegePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	privilege := models.ApplicationPrivilege{
+		Application: d.Get("application").(string),
+		Name:        d.Get("name").(string),
+		Actions:     utils.ExpandStringSet(d.Get("actions").(*schema.Set)),
+	}
+	id, diags := client.ID(ctx, applicationPrivilegeId(privilege.Application, privilege.Name))
+	if diags.HasError() {
+		return diags
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		metadata := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
+			return diag.FromErr(err)
+		}
+		privilege.Metadata = metadata
+	}
+
+	if diags := elasticsearch.PutApplicationPrivilege(ctx, client, &privilege); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceSecurityApplicationPrivilegeRead(ctx, d, meta)
+}
+
+func resourceSecurityApplicationPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	application, name, diags := applicationPrivilegeFromId(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	privilege, diags := elasticsearch.GetApplicationPrivilege(ctx, client, application, name)
+	if privilege == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Application privilege "%s" of application "%s" not found, removing from state`, name, application))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("application", privilege.Application); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", privilege.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("actions", privilege.Actions); err != nil {
+		return diag.FromErr(err)
+	}
+	metadata, err := json.Marshal(privilege.Metadata)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", string(metadata)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSecurityApplicationPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	application, name, diags := applicationPrivilegeFromId(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeleteApplicationPrivilege(ctx, client, application, name); diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+// the resource id of an application privilege is <application>:<name>, `:` is allowed in neither of them
+func applicationPrivilegeId(application, name string) string {
+	return fmt.Sprintf("%s:%s", application, name)
+}
+
+func applicationPrivilegeFromId(id string) (string, string, diag.Diagnostics) {
+	resourceId, diags := clients.ResourceIDFromStr(id)
+	if diags.HasError() {
+		return "", "", diags
+	}
+	application, name, ok := strings.Cut(resourceId, ":")
+	if !ok {
+		return "", "", diag.Errorf("Wrong resource ID. Resource ID must have following format: <cluster_uuid>/<application>:<name>")
+	}
+	return application, name, nil
+}
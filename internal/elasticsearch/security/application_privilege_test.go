@@ -0,0 +1,121 @@
+package security_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSecurityApplicationPrivilege(t *testing.T) {
+	application := "app" + sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityApplicationPrivilegeDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityApplicationPrivilegeCreate(application),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_application_privilege.test", "application", application),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_application_privilege.test", "name", "read"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_application_privilege.test", "actions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_application_privilege.test", "actions.*", "data:read/*"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_application_privilege.test", "metadata", `{"description":"Read access"}`),
+				),
+			},
+			{
+				Config: testAccResourceSecurityApplicationPrivilegeUpdate(application),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_application_privilege.test", "name", "read"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_application_privilege.test", "actions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_application_privilege.test", "actions.*", "data:read/*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_application_privilege.test", "actions.*", "action:login"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_application_privilege.test", "metadata", `{"description":"Read access and login"}`),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_application_privilege.test", "actions.*", "action:login"),
+				),
+			},
+			{
+				ResourceName:      "elasticstack_elasticsearch_security_application_privilege.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityApplicationPrivilegeCreate(application string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_application_privilege" "test" {
+  application = "%s"
+  name        = "read"
+  actions     = ["data:read/*"]
+
+  metadata = jsonencode({
+    description = "Read access"
+  })
+}
+	`, application)
+}
+
+func testAccResourceSecurityApplicationPrivilegeUpdate(application string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_application_privilege" "test" {
+  application = "%s"
+  name        = "read"
+  actions     = ["data:read/*", "action:login"]
+
+  metadata = jsonencode({
+    description = "Read access and login"
+  })
+}
+
+data "elasticstack_elasticsearch_security_application_privilege" "test" {
+  application = elasticstack_elasticsearch_security_application_privilege.test.application
+  name        = elasticstack_elasticsearch_security_application_privilege.test.name
+}
+	`, application)
+}
+
+func checkResourceSecurityApplicationPrivilegeDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_security_application_privilege" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+		application, name, _ := strings.Cut(compId.ResourceId, ":")
+
+		res, err := client.GetESClient().Security.GetPrivileges(
+			client.GetESClient().Security.GetPrivileges.WithApplication(application),
+			client.GetESClient().Security.GetPrivileges.WithName(name),
+		)
+		if err != nil {
+			return err
+		}
+
+		if res.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("application privilege (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityBuiltinPrivileges(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityBuiltinPrivileges,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_builtin_privileges.test", "cluster.*", "monitor"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_builtin_privileges.test", "cluster.*", "manage_security"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_builtin_privileges.test", "index.*", "read"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_builtin_privileges.test", "index.*", "view_index_metadata"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceSecurityBuiltinPrivileges = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_builtin_privileges" "test" {}
+`
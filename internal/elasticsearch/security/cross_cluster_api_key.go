@@ -0,0 +1,348 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var CrossClusterAPIKeyMinVersion = version.Must(version.NewVersion("8.10.0")) // Remote cluster security with API keys added in 8.10
+
+func ResourceCrossClusterApiKey() *schema.Resource {
+	apikeySchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Specifies the name for this API key.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 1024),
+				validation.StringMatch(regexp.MustCompile(`^([[:graph:]]| )+$`), "must contain alphanumeric characters (a-z, A-Z, 0-9), spaces, punctuation, and printable symbols in the Basic Latin (ASCII) block. Leading or trailing whitespace is not allowed"),
+			),
+		},
+		"access": {
+			Description: "The indices the remote clusters can search or replicate through the API key.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"search": {
+						Description:  "The indices available to cross-cluster search.",
+						Type:         schema.TypeList,
+						Optional:     true,
+						AtLeastOneOf: []string{"access.0.search", "access.0.replication"},
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"names": {
+									Description: "A list of indices, aliases or data streams. Wildcard (`*`) expressions are supported.",
+									Type:        schema.TypeSet,
+									Required:    true,
+									MinItems:    1,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"field_security": {
+									Description: "The document fields that can be searched.",
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"grant": {
+												Description: "List of the fields to grant the access to.",
+												Type:        schema.TypeSet,
+												Optional:    true,
+												Elem: &schema.Schema{
+													Type: schema.TypeString,
+												},
+											},
+											"except": {
+												Description: "List of the fields to which the grants will not be applied.",
+												Type:        schema.TypeSet,
+												Optional:    true,
+												Elem: &schema.Schema{
+													Type: schema.TypeString,
+												},
+											},
+										},
+									},
+								},
+								"query": {
+									Description:      "A search query that defines the documents that can be searched.",
+									Type:             schema.TypeString,
+									Optional:         true,
+									ValidateFunc:     validation.StringIsJSON,
+									DiffSuppressFunc: utils.DiffJsonSuppress,
+								},
+								"allow_restricted_indices": {
+									Description: "Include matching restricted indices in names parameter.",
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     false,
+								},
+							},
+						},
+					},
+					"replication": {
+						Description:  "The indices available to cross-cluster replication.",
+						Type:         schema.TypeList,
+						Optional:     true,
+						AtLeastOneOf: []string{"access.0.search", "access.0.replication"},
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"names": {
+									Description: "A list of indices, aliases or data streams. Wildcard (`*`) expressions are supported.",
+									Type:        schema.TypeSet,
+									Required:    true,
+									MinItems:    1,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"expiration": {
+			Description: "Expiration time for the API key. By default, API keys never expire.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"expiration_timestamp": {
+			Description: "Expiration time in milliseconds for the API key. By default, API keys never expire.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"metadata": {
+			Description:      "Arbitrary metadata that you want to associate with the API key.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"api_key": {
+			Description: "Generated API Key.",
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Computed:    true,
+		},
+		"encoded": {
+			Description: "API key credentials which is the Base64-encoding of the UTF-8 representation of the id and api_key joined by a colon (:). Used as `cluster.remote.<alias>.credentials` in the keystore of the local cluster.",
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(apikeySchema)
+
+	return &schema.Resource{
+		Description: "Creates an API key of the `cross_cluster` type, used by remote clusters to connect to the cluster. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-create-cross-cluster-api-key.html",
+
+		CreateContext: resourceSecurityCrossClusterApiKeyCreate,
+		UpdateContext: resourceSecurityCrossClusterApiKeyUpdate,
+		ReadContext:   resourceSecurityCrossClusterApiKeyRead,
+		DeleteContext: resourceSecurityApiKeyDelete,
+
+		Schema: apikeySchema,
+	}
+}
+
+func resourceSecurityCrossClusterApiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	if serverVersion.LessThan(CrossClusterAPIKeyMinVersion) {
+		return diag.Errorf("Cross-cluster API keys are supported only for Elasticsearch v%s and above", CrossClusterAPIKeyMinVersion.String())
+	}
+
+	apikey, diags := expandCrossClusterApiKey(d)
+	if diags.HasError() {
+		return diags
+	}
+	putResponse, diags := elasticsearch.PutCrossClusterApiKey(ctx, client, apikey)
+	if diags.HasError() {
+		return diags
+	}
+
+	id, diags := client.ID(ctx, putResponse.Id)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("api_key", putResponse.Key); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("encoded", putResponse.EncodedKey); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return resourceSecurityCrossClusterApiKeyRead(ctx, d, meta)
+}
+
+func resourceSecurityCrossClusterApiKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	apikey, diags := expandCrossClusterApiKey(d)
+	if diags.HasError() {
+		return diags
+	}
+	if diags := elasticsearch.UpdateCrossClusterApiKey(ctx, client, compId.ResourceId, apikey); diags.HasError() {
+		return diags
+	}
+
+	return resourceSecurityCrossClusterApiKeyRead(ctx, d, meta)
+}
+
+func resourceSecurityCrossClusterApiKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	apikey, diags := elasticsearch.GetApiKey(ctx, client, compId.ResourceId)
+	if apikey == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`API key "%s" not found, removing from state`, compId.ResourceId))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("name", apikey.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("expiration_timestamp", apikey.Expiration); err != nil {
+		return diag.FromErr(err)
+	}
+	// the access is only returned by the more recent versions, it is kept from the configuration otherwise
+	if apikey.Access != nil {
+		if err := d.Set("access", flattenCrossClusterApiKeyAccess(apikey.Access)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	metadata, err := json.Marshal(apikey.Metadata)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", string(metadata)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func expandCrossClusterApiKey(d *schema.ResourceData) (*models.CrossClusterApiKey, diag.Diagnostics) {
+	apikey := models.CrossClusterApiKey{
+		Name:       d.Get("name").(string),
+		Expiration: d.Get("expiration").(string),
+	}
+
+	access := d.Get("access").([]interface{})[0].(map[string]interface{})
+	for _, s := range access["search"].([]interface{}) {
+		search := s.(map[string]interface{})
+		searchAccess := models.CrossClusterSearchAccess{
+			Names: utils.ExpandStringSet(search["names"].(*schema.Set)),
+		}
+		if q := search["query"].(string); q != "" {
+			searchAccess.Query = &q
+		}
+		if fs := search["field_security"].([]interface{}); len(fs) > 0 && fs[0] != nil {
+			fieldSecurity := fs[0].(map[string]interface{})
+			searchAccess.FieldSecurity = &models.FieldSecurity{
+				Grant:  utils.ExpandStringSet(fieldSecurity["grant"].(*schema.Set)),
+				Except: utils.ExpandStringSet(fieldSecurity["except"].(*schema.Set)),
+			}
+		}
+		allowRestrictedIndices := search["allow_restricted_indices"].(bool)
+		searchAccess.AllowRestrictedIndices = &allowRestrictedIndices
+		apikey.Access.Search = append(apikey.Access.Search, searchAccess)
+	}
+	for _, r := range access["replication"].([]interface{}) {
+		replication := r.(map[string]interface{})
+		apikey.Access.Replication = append(apikey.Access.Replication, models.CrossClusterReplicationAccess{
+			Names: utils.ExpandStringSet(replication["names"].(*schema.Set)),
+		})
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		metadata := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		apikey.Metadata = metadata
+	}
+	return &apikey, nil
+}
+
+func flattenCrossClusterApiKeyAccess(access *models.CrossClusterApiKeyAccess) []interface{} {
+	search := make([]interface{}, len(access.Search))
+	for i, s := range access.Search {
+		searchAccess := map[string]interface{}{
+			"names":                    s.Names,
+			"allow_restricted_indices": s.AllowRestrictedIndices != nil && *s.AllowRestrictedIndices,
+		}
+		if s.Query != nil {
+			searchAccess["query"] = *s.Query
+		}
+		if s.FieldSecurity != nil {
+			searchAccess["field_security"] = []interface{}{map[string]interface{}{
+				"grant":  s.FieldSecurity.Grant,
+				"except": s.FieldSecurity.Except,
+			}}
+		}
+		search[i] = searchAccess
+	}
+	replication := make([]interface{}, len(access.Replication))
+	for i, r := range access.Replication {
+		replication[i] = map[string]interface{}{
+			"names": r.Names,
+		}
+	}
+	return []interface{}{map[string]interface{}{
+		"search":      search,
+		"replication": replication,
+	}}
+}
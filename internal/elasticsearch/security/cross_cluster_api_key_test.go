@@ -0,0 +1,92 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/security"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSecurityCrossClusterApiKey(t *testing.T) {
+	apiKeyName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityApiKeyDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.CrossClusterAPIKeyMinVersion),
+				Config:   testAccResourceSecurityCrossClusterApiKeyCreate(apiKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_cross_cluster_api_key.test", "name", apiKeyName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_cross_cluster_api_key.test", "access.0.search.#", "1"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_cross_cluster_api_key.test", "access.0.search.0.names.*", "logs-*"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_cross_cluster_api_key.test", "access.0.replication.#", "0"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_cross_cluster_api_key.test", "encoded"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.CrossClusterAPIKeyMinVersion),
+				Config:   testAccResourceSecurityCrossClusterApiKeyUpdate(apiKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_cross_cluster_api_key.test", "access.0.search.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_cross_cluster_api_key.test", "access.0.replication.#", "1"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_cross_cluster_api_key.test", "access.0.replication.0.names.*", "archive-*"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_cross_cluster_api_key.test", "metadata", `{"team":"search"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityCrossClusterApiKeyCreate(apiKeyName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_cross_cluster_api_key" "test" {
+  name = "%s"
+
+  access {
+    search {
+      names = ["logs-*"]
+    }
+  }
+
+  expiration = "1d"
+}
+	`, apiKeyName)
+}
+
+func testAccResourceSecurityCrossClusterApiKeyUpdate(apiKeyName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_cross_cluster_api_key" "test" {
+  name = "%s"
+
+  access {
+    search {
+      names = ["logs-*"]
+    }
+    replication {
+      names = ["archive-*"]
+    }
+  }
+
+  metadata = jsonencode({
+    team = "search"
+  })
+
+  expiration = "1d"
+}
+	`, apiKeyName)
+}
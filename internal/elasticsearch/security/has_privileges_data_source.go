@@ -0,0 +1,326 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceHasPrivileges() *schema.Resource {
+	privilegesSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"cluster": {
+			Description:  "The cluster privileges to check.",
+			Type:         schema.TypeSet,
+			Optional:     true,
+			AtLeastOneOf: []string{"cluster", "index", "application"},
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"index": {
+			Description:  "The index privileges to check.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			AtLeastOneOf: []string{"cluster", "index", "application"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"names": {
+						Description: "A list of indices, or index patterns.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"privileges": {
+						Description: "The index privileges to check on the indices.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"allow_restricted_indices": {
+						Description: "Include matching restricted indices in names parameter.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+		"application": {
+			Description:  "The application privileges to check.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			AtLeastOneOf: []string{"cluster", "index", "application"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"application": {
+						Description: "The name of the application.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"privileges": {
+						Description: "The application privileges to check on the resources.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"resources": {
+						Description: "The resources of the application.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"run_as": {
+			Description:   "Checks the privileges of this user, on behalf of the user of the provider, which must have the `run_as` privilege for it.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"api_key"},
+		},
+		"api_key": {
+			Description:   "Checks the privileges of this API key, e.g. the `encoded` attribute of `elasticstack_elasticsearch_security_api_key`.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ConflictsWith: []string{"run_as"},
+		},
+		"username": {
+			Description: "The name of the user whose privileges have been checked.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"has_all_requested": {
+			Description: "Whether the user has all the privileges checked.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"cluster_privileges": {
+			Description: "Whether the user has each of the cluster privileges.",
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeBool,
+			},
+		},
+		"index_privileges": {
+			Description: "Whether the user has each of the index privileges, by index.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The index, or index pattern.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"privileges": {
+						Description: "Whether the user has each of the privileges on the index.",
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeBool,
+						},
+					},
+				},
+			},
+		},
+		"application_privileges": {
+			Description: "Whether the user has each of the application privileges, by application and resource.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"application": {
+						Description: "The name of the application.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"resource": {
+						Description: "The resource of the application.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"privileges": {
+						Description: "Whether the user has each of the privileges on the resource.",
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeBool,
+						},
+					},
+				},
+			},
+		},
+		"missing_privileges": {
+			Description: "The privileges the user lacks, e.g. `manage_ilm`, `write on logs-*` or `read on data/* of myapp`.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(privilegesSchema)
+
+	return &schema.Resource{
+		Description: "Checks the privileges of the user of the provider, of another user or of an API key. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-has-privileges.html",
+
+		ReadContext: dataSourceSecurityHasPrivilegesRead,
+
+		Schema: privilegesSchema,
+	}
+}
+
+func dataSourceSecurityHasPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	var privileges models.HasPrivileges
+	privileges.Cluster = utils.ExpandStringSet(d.Get("cluster").(*schema.Set))
+	for _, v := range d.Get("index").([]interface{}) {
+		index := v.(map[string]interface{})
+		allowRestrictedIndices := index["allow_restricted_indices"].(bool)
+		privileges.Index = append(privileges.Index, models.HasIndexPrivileges{
+			Names:                  utils.ExpandStringSet(index["names"].(*schema.Set)),
+			Privileges:             utils.ExpandStringSet(index["privileges"].(*schema.Set)),
+			AllowRestrictedIndices: &allowRestrictedIndices,
+		})
+	}
+	for _, v := range d.Get("application").([]interface{}) {
+		application := v.(map[string]interface{})
+		privileges.Application = append(privileges.Application, models.HasApplicationPrivileges{
+			Application: application["application"].(string),
+			Privileges:  utils.ExpandStringSet(application["privileges"].(*schema.Set)),
+			Resources:   utils.ExpandStringSet(application["resources"].(*schema.Set)),
+		})
+	}
+
+	response, diags := elasticsearch.HasPrivileges(ctx, client, &privileges, d.Get("run_as").(string), d.Get("api_key").(string))
+	if diags.HasError() {
+		return diags
+	}
+
+	privilegesBytes, err := json.Marshal(privileges)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hash, err := utils.StringToHash(string(privilegesBytes))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id, diags := client.ID(ctx, fmt.Sprintf("has-privileges:%s:%s", response.Username, *hash))
+	if diags.HasError() {
+		return diags
+	}
+
+	missing := make([]string, 0)
+	for _, privilege := range sortedPrivileges(response.Cluster) {
+		if !response.Cluster[privilege] {
+			missing = append(missing, privilege)
+		}
+	}
+
+	indexPrivileges := make([]interface{}, 0, len(response.Index))
+	for _, name := range sortedPrivilegesKeys(response.Index) {
+		for _, privilege := range sortedPrivileges(response.Index[name]) {
+			if !response.Index[name][privilege] {
+				missing = append(missing, fmt.Sprintf("%s on %s", privilege, name))
+			}
+		}
+		indexPrivileges = append(indexPrivileges, map[string]interface{}{
+			"name":       name,
+			"privileges": response.Index[name],
+		})
+	}
+
+	applicationPrivileges := make([]interface{}, 0)
+	applications := make([]string, 0, len(response.Application))
+	for application := range response.Application {
+		applications = append(applications, application)
+	}
+	sort.Strings(applications)
+	for _, application := range applications {
+		resources := response.Application[application]
+		for _, resource := range sortedPrivilegesKeys(resources) {
+			for _, privilege := range sortedPrivileges(resources[resource]) {
+				if !resources[resource][privilege] {
+					missing = append(missing, fmt.Sprintf("%s on %s of %s", privilege, resource, application))
+				}
+			}
+			applicationPrivileges = append(applicationPrivileges, map[string]interface{}{
+				"application": application,
+				"resource":    resource,
+				"privileges":  resources[resource],
+			})
+		}
+	}
+
+	if err := d.Set("username", response.Username); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("has_all_requested", response.HasAllRequested); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cluster_privileges", response.Cluster); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("index_privileges", indexPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("application_privileges", applicationPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("missing_privileges", missing); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
+
+func sortedPrivileges(privileges map[string]bool) []string {
+	names := make([]string, 0, len(privileges))
+	for name := range privileges {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedPrivilegesKeys(m map[string]map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,84 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityHasPrivileges(t *testing.T) {
+	username := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityHasPrivileges(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.provider", "has_all_requested", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.provider", "cluster_privileges.manage_ilm", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.provider", "index_privileges.0.name", "logs-*"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.provider", "index_privileges.0.privileges.write", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.provider", "missing_privileges.#", "0"),
+
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.run_as", "username", username),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.run_as", "has_all_requested", "false"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.run_as", "cluster_privileges.monitor", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.run_as", "cluster_privileges.manage_ilm", "false"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.run_as", "index_privileges.0.privileges.read", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.run_as", "index_privileges.0.privileges.write", "false"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.run_as", "missing_privileges.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.run_as", "missing_privileges.0", "manage_ilm"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.run_as", "missing_privileges.1", "write on logs-*"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecurityHasPrivileges(username string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name    = "%[1]s"
+  cluster = ["monitor"]
+
+  indices {
+    names      = ["logs-*"]
+    privileges = ["read"]
+  }
+}
+
+resource "elasticstack_elasticsearch_security_user" "test" {
+  username = "%[1]s"
+  roles    = [elasticstack_elasticsearch_security_role.test.name]
+  password = "qwerty123"
+}
+
+data "elasticstack_elasticsearch_security_has_privileges" "provider" {
+  cluster = ["manage_ilm"]
+
+  index {
+    names      = ["logs-*"]
+    privileges = ["write"]
+  }
+}
+
+data "elasticstack_elasticsearch_security_has_privileges" "run_as" {
+  run_as  = elasticstack_elasticsearch_security_user.test.username
+  cluster = ["monitor", "manage_ilm"]
+
+  index {
+    names      = ["logs-*"]
+    privileges = ["read", "write"]
+  }
+}
+`, username)
+}
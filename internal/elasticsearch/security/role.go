@@ -4,18 +4,26 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var (
+	RoleRemoteIndicesMinVersion = version.Must(version.NewVersion("8.8.0"))  // Remote indices privileges added in 8.8
+	RoleRemoteClusterMinVersion = version.Must(version.NewVersion("8.15.0")) // Remote cluster privileges added in 8.15
+	RoleDescriptionMinVersion   = version.Must(version.NewVersion("8.15.0")) // Role description added in 8.15
+)
+
 func ResourceRole() *schema.Resource {
 	roleSchema := map[string]*schema.Schema{
 		"id": {
@@ -29,6 +37,11 @@ func ResourceRole() *schema.Resource {
 			Required:    true,
 			ForceNew:    true,
 		},
+		"description": {
+			Description: "The description of the role.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
 		"applications": {
 			Description: "A list of application privilege entries.",
 			Type:        schema.TypeSet,
@@ -137,6 +150,102 @@ func ResourceRole() *schema.Resource {
 				},
 			},
 		},
+		"remote_indices": {
+			Description: "A list of remote indices permissions entries, used for the cross-cluster search and replication with API keys.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"clusters": {
+						Description: "A list of remote cluster aliases (or patterns) to which the permissions in this entry apply.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"field_security": {
+						Description: "The document fields that the owners of the role have read access to.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"grant": {
+									Description: "List of the fields to grant the access to.",
+									Type:        schema.TypeSet,
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"except": {
+									Description: "List of the fields to which the grants will not be applied.",
+									Type:        schema.TypeSet,
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+					"names": {
+						Description: "A list of indices (or index name patterns) to which the permissions in this entry apply.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"privileges": {
+						Description: "The index level privileges that the owners of the role have on the specified indices.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"query": {
+						Description:      "A search query that defines the documents the owners of the role have read access to.",
+						Type:             schema.TypeString,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+						Optional:         true,
+					},
+					"allow_restricted_indices": {
+						Description: "Include matching restricted indices in names parameter. Usage is strongly discouraged as it can grant unrestricted operations on critical data, make the entire system unstable or leak sensitive information.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"remote_cluster": {
+			Description: "A list of remote cluster permissions entries.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"clusters": {
+						Description: "A list of remote cluster aliases (or patterns) to which the permissions in this entry apply.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"privileges": {
+						Description: "The cluster level privileges that the owners of the role have on the remote clusters, e.g. `monitor_enrich`.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
 		"metadata": {
 			Description:      "Optional meta-data.",
 			Type:             schema.TypeString,
@@ -165,6 +274,8 @@ func ResourceRole() *schema.Resource {
 		ReadContext:   resourceSecurityRoleRead,
 		DeleteContext: resourceSecurityRoleDelete,
 
+		CustomizeDiff: resourceSecurityRoleCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -185,6 +296,29 @@ func resourceSecurityRolePut(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	var role models.Role
 	role.Name = roleId
+
+	_, hasRemoteIndices := d.GetOk("remote_indices")
+	_, hasRemoteCluster := d.GetOk("remote_cluster")
+	description, hasDescription := d.GetOk("description")
+	if hasRemoteIndices || hasRemoteCluster || hasDescription {
+		serverVersion, diags := client.ServerVersion(ctx)
+		if diags.HasError() {
+			return diags
+		}
+		if hasRemoteIndices && serverVersion.LessThan(RoleRemoteIndicesMinVersion) {
+			return diag.Errorf("'remote_indices' is supported only for Elasticsearch v%s and above", RoleRemoteIndicesMinVersion.String())
+		}
+		if hasRemoteCluster && serverVersion.LessThan(RoleRemoteClusterMinVersion) {
+			return diag.Errorf("'remote_cluster' is supported only for Elasticsearch v%s and above", RoleRemoteClusterMinVersion.String())
+		}
+		if hasDescription && serverVersion.LessThan(RoleDescriptionMinVersion) {
+			return diag.Errorf("'description' is supported only for Elasticsearch v%s and above", RoleDescriptionMinVersion.String())
+		}
+	}
+	if hasDescription {
+		role.Description = description.(string)
+	}
+
 	if v, ok := d.GetOk("applications"); ok {
 		definedApps := v.(*schema.Set)
 		applications := make([]models.Application, definedApps.Len())
@@ -233,57 +367,35 @@ func resourceSecurityRolePut(ctx context.Context, d *schema.ResourceData, meta i
 		definedIndices := v.(*schema.Set)
 		indices := make([]models.IndexPerms, definedIndices.Len())
 		for i, idx := range definedIndices.List() {
-			index := idx.(map[string]interface{})
-
-			definedNames := index["names"].(*schema.Set)
-			names := make([]string, definedNames.Len())
-			for i, name := range definedNames.List() {
-				names[i] = name.(string)
-			}
-			definedPrivs := index["privileges"].(*schema.Set)
-			privs := make([]string, definedPrivs.Len())
-			for i, pr := range definedPrivs.List() {
-				privs[i] = pr.(string)
-			}
+			indices[i] = expandIndexPerms(idx.(map[string]interface{}))
+		}
+		role.Indices = indices
+	}
 
-			newIndex := models.IndexPerms{
-				Names:      names,
-				Privileges: privs,
+	if v, ok := d.GetOk("remote_indices"); ok {
+		definedRemoteIndices := v.(*schema.Set)
+		remoteIndices := make([]models.RemoteIndexPerms, definedRemoteIndices.Len())
+		for i, idx := range definedRemoteIndices.List() {
+			index := idx.(map[string]interface{})
+			remoteIndices[i] = models.RemoteIndexPerms{
+				IndexPerms: expandIndexPerms(index),
+				Clusters:   utils.ExpandStringSet(index["clusters"].(*schema.Set)),
 			}
+		}
+		role.RemoteIndices = remoteIndices
+	}
 
-			if query := index["query"].(string); query != "" {
-				newIndex.Query = &query
-			}
-			if fieldSec := index["field_security"].([]interface{}); len(fieldSec) > 0 {
-				fieldSecurity := models.FieldSecurity{}
-				// there must be only 1 entry
-				definedFieldSec := fieldSec[0].(map[string]interface{})
-
-				// grants
-				if gr := definedFieldSec["grant"].(*schema.Set); gr != nil {
-					grants := make([]string, gr.Len())
-					for i, grant := range gr.List() {
-						grants[i] = grant.(string)
-					}
-					fieldSecurity.Grant = grants
-				}
-				// except
-				if exp := definedFieldSec["except"].(*schema.Set); exp != nil {
-					excepts := make([]string, exp.Len())
-					for i, except := range exp.List() {
-						excepts[i] = except.(string)
-					}
-					fieldSecurity.Except = excepts
-				}
-				newIndex.FieldSecurity = &fieldSecurity
+	if v, ok := d.GetOk("remote_cluster"); ok {
+		definedRemoteCluster := v.(*schema.Set)
+		remoteCluster := make([]models.RemoteClusterPerms, definedRemoteCluster.Len())
+		for i, rc := range definedRemoteCluster.List() {
+			remote := rc.(map[string]interface{})
+			remoteCluster[i] = models.RemoteClusterPerms{
+				Clusters:   utils.ExpandStringSet(remote["clusters"].(*schema.Set)),
+				Privileges: utils.ExpandStringSet(remote["privileges"].(*schema.Set)),
 			}
-
-			allowRestrictedIndices := index["allow_restricted_indices"].(bool)
-			newIndex.AllowRestrictedIndices = &allowRestrictedIndices
-
-			indices[i] = newIndex
 		}
-		role.Indices = indices
+		role.RemoteCluster = remoteCluster
 	}
 
 	if v, ok := d.GetOk("metadata"); ok {
@@ -311,6 +423,119 @@ func resourceSecurityRolePut(ctx context.Context, d *schema.ResourceData, meta i
 	return resourceSecurityRoleRead(ctx, d, meta)
 }
 
+func expandIndexPerms(index map[string]interface{}) models.IndexPerms {
+	definedNames := index["names"].(*schema.Set)
+	names := make([]string, definedNames.Len())
+	for i, name := range definedNames.List() {
+		names[i] = name.(string)
+	}
+	definedPrivs := index["privileges"].(*schema.Set)
+	privs := make([]string, definedPrivs.Len())
+	for i, pr := range definedPrivs.List() {
+		privs[i] = pr.(string)
+	}
+
+	newIndex := models.IndexPerms{
+		Names:      names,
+		Privileges: privs,
+	}
+
+	if query := index["query"].(string); query != "" {
+		newIndex.Query = &query
+	}
+	if fieldSec := index["field_security"].([]interface{}); len(fieldSec) > 0 {
+		fieldSecurity := models.FieldSecurity{}
+		// there must be only 1 entry
+		definedFieldSec := fieldSec[0].(map[string]interface{})
+
+		// grants
+		if gr := definedFieldSec["grant"].(*schema.Set); gr != nil {
+			grants := make([]string, gr.Len())
+			for i, grant := range gr.List() {
+				grants[i] = grant.(string)
+			}
+			fieldSecurity.Grant = grants
+		}
+		// except
+		if exp := definedFieldSec["except"].(*schema.Set); exp != nil {
+			excepts := make([]string, exp.Len())
+			for i, except := range exp.List() {
+				excepts[i] = except.(string)
+			}
+			fieldSecurity.Except = excepts
+		}
+		newIndex.FieldSecurity = &fieldSecurity
+	}
+
+	allowRestrictedIndices := index["allow_restricted_indices"].(bool)
+	newIndex.AllowRestrictedIndices = &allowRestrictedIndices
+
+	return newIndex
+}
+
+func resourceSecurityRoleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("cluster", "indices", "remote_indices", "remote_cluster") {
+		return nil
+	}
+
+	cluster := utils.ExpandStringSet(d.Get("cluster").(*schema.Set))
+	index := make([]string, 0)
+	for _, v := range []string{"indices", "remote_indices"} {
+		for _, idx := range d.Get(v).(*schema.Set).List() {
+			index = append(index, utils.ExpandStringSet(idx.(map[string]interface{})["privileges"].(*schema.Set))...)
+		}
+	}
+	remoteCluster := make([]string, 0)
+	for _, rc := range d.Get("remote_cluster").(*schema.Set).List() {
+		remoteCluster = append(remoteCluster, utils.ExpandStringSet(rc.(map[string]interface{})["privileges"].(*schema.Set))...)
+	}
+	if len(cluster)+len(index)+len(remoteCluster) == 0 {
+		return nil
+	}
+
+	client, diags := clients.NewApiClientFromDiff(d, meta)
+	if diags.HasError() {
+		return utils.DiagsAsError(diags)
+	}
+	builtin, diags := elasticsearch.GetBuiltinPrivileges(ctx, client)
+	if diags.HasError() {
+		// the cluster might not be reachable yet during the plan, e.g. when it's created in the same run
+		tflog.Warn(ctx, fmt.Sprintf("Skipping the validation of the role privileges: %s", utils.DiagsAsError(diags)))
+		return nil
+	}
+
+	unknown := make([]string, 0)
+	unknown = append(unknown, unknownPrivileges("cluster", cluster, builtin.Cluster)...)
+	unknown = append(unknown, unknownPrivileges("index", index, builtin.Index)...)
+	// the remote cluster privileges are only returned by the versions supporting them
+	if builtin.RemoteCluster != nil {
+		unknown = append(unknown, unknownPrivileges("remote cluster", remoteCluster, builtin.RemoteCluster)...)
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown privileges: %s. Check the names against the privileges returned by GET _security/privilege/_builtin", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// unknownPrivileges returns the privileges which are neither built-in nor action patterns, e.g. `indices:data/read/*`
+func unknownPrivileges(kind string, privileges []string, builtin []string) []string {
+	known := make(map[string]bool, len(builtin))
+	for _, p := range builtin {
+		known[p] = true
+	}
+	unknown := make([]string, 0)
+	for _, p := range privileges {
+		// the privilege is empty while it is not known yet
+		if p == "" || known[p] || strings.Contains(p, ":") {
+			continue
+		}
+		known[p] = true
+		unknown = append(unknown, fmt.Sprintf(`%s privilege "%s"`, kind, p))
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
 func resourceSecurityRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
@@ -337,6 +562,10 @@ func resourceSecurityRoleRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("description", role.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
 	apps := role.Applications
 	applications := flattenApplicationsData(&apps)
 	if err := d.Set("applications", applications); err != nil {
@@ -363,6 +592,14 @@ func resourceSecurityRoleRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("remote_indices", flattenRemoteIndicesData(role.RemoteIndices)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("remote_cluster", flattenRemoteClusterData(role.RemoteCluster)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if role.Metadata != nil {
 		metadata, err := json.Marshal(role.Metadata)
 		if err != nil {
@@ -400,25 +637,50 @@ func flattenIndicesData(indices *[]models.IndexPerms) []interface{} {
 		oindx := make([]interface{}, len(*indices))
 
 		for i, index := range *indices {
-			oi := make(map[string]interface{})
-			oi["names"] = index.Names
-			oi["privileges"] = index.Privileges
-			oi["query"] = index.Query
-			oi["allow_restricted_indices"] = index.AllowRestrictedIndices
-
-			if index.FieldSecurity != nil {
-				fsec := make(map[string]interface{})
-				fsec["grant"] = index.FieldSecurity.Grant
-				fsec["except"] = index.FieldSecurity.Except
-				oi["field_security"] = []interface{}{fsec}
-			}
-			oindx[i] = oi
+			oindx[i] = flattenIndexPerms(index)
 		}
 		return oindx
 	}
 	return make([]interface{}, 0)
 }
 
+func flattenRemoteIndicesData(indices []models.RemoteIndexPerms) []interface{} {
+	oindx := make([]interface{}, len(indices))
+	for i, index := range indices {
+		oi := flattenIndexPerms(index.IndexPerms)
+		oi["clusters"] = index.Clusters
+		oindx[i] = oi
+	}
+	return oindx
+}
+
+func flattenIndexPerms(index models.IndexPerms) map[string]interface{} {
+	oi := make(map[string]interface{})
+	oi["names"] = index.Names
+	oi["privileges"] = index.Privileges
+	oi["query"] = index.Query
+	oi["allow_restricted_indices"] = index.AllowRestrictedIndices
+
+	if index.FieldSecurity != nil {
+		fsec := make(map[string]interface{})
+		fsec["grant"] = index.FieldSecurity.Grant
+		fsec["except"] = index.FieldSecurity.Except
+		oi["field_security"] = []interface{}{fsec}
+	}
+	return oi
+}
+
+func flattenRemoteClusterData(remoteCluster []models.RemoteClusterPerms) []interface{} {
+	orc := make([]interface{}, len(remoteCluster))
+	for i, rc := range remoteCluster {
+		orc[i] = map[string]interface{}{
+			"clusters":   rc.Clusters,
+			"privileges": rc.Privileges,
+		}
+	}
+	return orc
+}
+
 func resourceSecurityRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceRoleMapping() *schema.Resource {
@@ -34,9 +35,20 @@ func ResourceRoleMapping() *schema.Resource {
 		},
 		"rules": {
 			Type:             schema.TypeString,
-			Required:         true,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
-			Description:      "The rules that determine which users should be matched by the mapping. A rule is a logical condition that is expressed by using a JSON DSL.",
+			ExactlyOneOf:     []string{"rules", "rule"},
+			Description:      "The rules that determine which users should be matched by the mapping. A rule is a logical condition that is expressed by using a JSON DSL. Rendered from `rule` when it is used.",
+		},
+		"rule": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			Elem:         roleMappingRuleSchema(0),
+			ExactlyOneOf: []string{"rules", "rule"},
+			Description:  "The rules that determine which users should be matched by the mapping, as nested blocks. Validated during the plan and rendered into `rules`.",
 		},
 		"roles": {
 			Type: schema.TypeSet,
@@ -75,6 +87,8 @@ func ResourceRoleMapping() *schema.Resource {
 		ReadContext:   resourceSecurityRoleMappingRead,
 		DeleteContext: resourceSecurityRoleMappingDelete,
 
+		CustomizeDiff: resourceSecurityRoleMappingCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -95,7 +109,13 @@ func resourceSecurityRoleMappingPut(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var rules map[string]interface{}
-	if err := json.Unmarshal([]byte(d.Get("rules").(string)), &rules); err != nil {
+	if v, ok := d.GetOk("rule"); ok {
+		r, err := expandRoleMappingRule(v.([]interface{})[0], "rule.0", "")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rules = r
+	} else if err := json.Unmarshal([]byte(d.Get("rules").(string)), &rules); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -122,6 +142,27 @@ func resourceSecurityRoleMappingPut(ctx context.Context, d *schema.ResourceData,
 	return resourceSecurityRoleMappingRead(ctx, d, meta)
 }
 
+func resourceSecurityRoleMappingCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("rule")
+	if !ok || !d.HasChange("rule") {
+		return nil
+	}
+	// the values might not be known yet during the plan
+	if !d.GetRawConfig().GetAttr("rule").IsWhollyKnown() {
+		return d.SetNewComputed("rules")
+	}
+
+	rules, err := expandRoleMappingRule(v.([]interface{})[0], "rule.0", "")
+	if err != nil {
+		return err
+	}
+	rulesBytes, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+	return d.SetNew("rules", string(rulesBytes))
+}
+
 func resourceSecurityRoleMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
@@ -175,6 +216,15 @@ func resourceSecurityRoleMappingRead(ctx context.Context, d *schema.ResourceData
 	if err := d.Set("rules", string(rules)); err != nil {
 		return diag.FromErr(err)
 	}
+	if _, ok := d.GetOk("rule"); ok {
+		if rule, ok := flattenRoleMappingRule(roleMapping.Rules, 0); ok {
+			if err := d.Set("rule", []interface{}{rule}); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			tflog.Warn(ctx, fmt.Sprintf(`The rules of role mapping "%s" can't be represented by the rule block, use rules instead`, resourceID))
+		}
+	}
 	if err := d.Set("metadata", string(metadata)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,193 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceRoleMappingEvaluation() *schema.Resource {
+	evaluationSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description:  "The name of the role mapping of the cluster to evaluate.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"name", "rules"},
+		},
+		"rules": {
+			Description:  "The rules to evaluate, in the JSON DSL of the role mappings, e.g. the `rules` of a `elasticstack_elasticsearch_security_role_mapping`. They are evaluated without connecting to the cluster.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			ExactlyOneOf: []string{"name", "rules"},
+		},
+		"roles": {
+			Description:   "The roles granted by the `rules` to the matching users.",
+			Type:          schema.TypeSet,
+			Optional:      true,
+			ConflictsWith: []string{"name"},
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"username": {
+			Description: "The username of the sample user.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"dn": {
+			Description: "The distinguished name of the sample user.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"groups": {
+			Description: "The groups of the sample user.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"realm": {
+			Description: "The name of the realm of the sample user.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"metadata": {
+			Description: "The metadata of the sample user, matched by the `metadata.<key>` fields.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"matched": {
+			Description: "Whether the sample user matches the rules. Always `false` when the role mapping is disabled.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"granted_roles": {
+			Description: "The roles the sample user would get.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"granted_role_templates": {
+			Description: "The role templates the sample user would get, as JSON. The templates are not rendered.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(evaluationSchema)
+
+	return &schema.Resource{
+		Description: "Evaluates the rules of a role mapping against a sample user, and reports the roles the user would get. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/role-mapping-resources.html",
+
+		ReadContext: dataSourceSecurityRoleMappingEvaluationRead,
+
+		Schema: evaluationSchema,
+	}
+}
+
+func dataSourceSecurityRoleMappingEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	roleMapping := models.RoleMapping{
+		Enabled: true,
+		Roles:   utils.ExpandStringSet(d.Get("roles").(*schema.Set)),
+	}
+	var id string
+	if name := d.Get("name").(string); name != "" {
+		client, diags := clients.NewApiClient(d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		compId, diags := client.ID(ctx, name)
+		if diags.HasError() {
+			return diags
+		}
+		mapping, diags := elasticsearch.GetRoleMapping(ctx, client, name)
+		if mapping == nil && diags == nil {
+			return diag.Errorf(`Role mapping "%s" not found`, name)
+		}
+		if diags.HasError() {
+			return diags
+		}
+		roleMapping = *mapping
+		id = compId.String()
+	} else {
+		if err := json.Unmarshal([]byte(d.Get("rules").(string)), &roleMapping.Rules); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	user := models.RoleMappingUser{
+		Username: d.Get("username").(string),
+		Dn:       d.Get("dn").(string),
+		Groups:   make([]string, 0),
+		Realm:    d.Get("realm").(string),
+		Metadata: d.Get("metadata").(map[string]interface{}),
+	}
+	for _, g := range d.Get("groups").([]interface{}) {
+		user.Groups = append(user.Groups, g.(string))
+	}
+
+	matched, err := EvaluateRoleMappingRules(roleMapping.Rules, &user)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	matched = matched && roleMapping.Enabled
+
+	grantedRoles := make([]string, 0)
+	grantedRoleTemplates := "[]"
+	if matched {
+		grantedRoles = append(grantedRoles, roleMapping.Roles...)
+		if len(roleMapping.RoleTemplates) > 0 {
+			roleTemplates, err := json.Marshal(roleMapping.RoleTemplates)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			grantedRoleTemplates = string(roleTemplates)
+		}
+	}
+
+	if err := d.Set("matched", matched); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("granted_roles", grantedRoles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("granted_role_templates", grantedRoleTemplates); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if id == "" {
+		// the rules are evaluated offline, the id is derived from the inputs
+		inputs, err := json.Marshal([]interface{}{roleMapping.Rules, roleMapping.Roles, user})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		hash, err := utils.StringToHash(string(inputs))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		id = fmt.Sprintf("role-mapping-evaluation/%s", *hash)
+	}
+	d.SetId(id)
+	return diags
+}
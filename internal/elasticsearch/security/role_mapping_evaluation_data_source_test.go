@@ -0,0 +1,94 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityRoleMappingEvaluation(t *testing.T) {
+	roleMappingName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityRoleMappingDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityRoleMappingEvaluation(roleMappingName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_role_mapping_evaluation.admin", "matched", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_role_mapping_evaluation.admin", "granted_roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_role_mapping_evaluation.admin", "granted_roles.*", "admin"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_role_mapping_evaluation.contractor", "matched", "false"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_role_mapping_evaluation.contractor", "granted_roles.#", "0"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_role_mapping_evaluation.offline", "matched", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_role_mapping_evaluation.offline", "granted_roles.0", "viewer"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecurityRoleMappingEvaluation(roleMappingName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role_mapping" "test" {
+  name  = "%s"
+  roles = ["admin", "user"]
+
+  rule {
+    all {
+      field {
+        name   = "realm.name"
+        values = ["ldap1"]
+      }
+    }
+    all {
+      field {
+        name   = "groups"
+        values = ["cn=admins,dc=example,dc=com"]
+      }
+    }
+    all {
+      except {
+        field {
+          name   = "metadata.contractor"
+          values = ["true"]
+        }
+      }
+    }
+  }
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_evaluation" "admin" {
+  name     = elasticstack_elasticsearch_security_role_mapping.test.name
+  username = "jdoe"
+  realm    = "ldap1"
+  groups   = ["CN=Admins, DC=example, DC=com"]
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_evaluation" "contractor" {
+  name     = elasticstack_elasticsearch_security_role_mapping.test.name
+  username = "jroe"
+  realm    = "ldap1"
+  groups   = ["cn=admins,dc=example,dc=com"]
+  metadata = {
+    contractor = "true"
+  }
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_evaluation" "offline" {
+  rules = jsonencode({
+    field = { username = "j*" }
+  })
+  roles    = ["viewer"]
+  username = "jdoe"
+}
+	`, roleMappingName)
+}
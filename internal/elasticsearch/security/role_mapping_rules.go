@@ -0,0 +1,320 @@
+package security
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// the schema can't be recursive, the rules are limited to this number of nested `any`, `all` and `except`
+const roleMappingRuleMaxDepth = 3
+
+var roleMappingRuleFieldRegexp = regexp.MustCompile(`^(username|dn|groups|realm\.name|metadata\..+)$`)
+
+func roleMappingRuleSchema(depth int) *schema.Resource {
+	ruleSchema := map[string]*schema.Schema{
+		"field": {
+			Description: "Matches the users whose field has one of the values.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description:  "The name of the field: `username`, `dn`, `groups`, `realm.name` or `metadata.<key>`.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringMatch(roleMappingRuleFieldRegexp, "must be one of `username`, `dn`, `groups`, `realm.name` or `metadata.<key>`"),
+					},
+					"values": {
+						Description: "The values to match. Wildcards (`*`, `?`) and regular expressions enclosed in `/` are supported.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+	if depth < roleMappingRuleMaxDepth {
+		child := roleMappingRuleSchema(depth + 1)
+		ruleSchema["any"] = &schema.Schema{
+			Description: "Matches the users matching any of the rules.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        child,
+		}
+		ruleSchema["all"] = &schema.Schema{
+			Description: "Matches the users matching all of the rules.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        child,
+		}
+		ruleSchema["except"] = &schema.Schema{
+			Description: "Matches the users not matching the rule. Only allowed within `all`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        child,
+		}
+	}
+	return &schema.Resource{Schema: ruleSchema}
+}
+
+// expandRoleMappingRule renders the rule block into the JSON DSL of the role mapping API
+func expandRoleMappingRule(v interface{}, path string, parent string) (map[string]interface{}, error) {
+	rule, _ := v.(map[string]interface{})
+	set := make([]string, 0)
+	for _, k := range []string{"any", "all", "except", "field"} {
+		if r, ok := rule[k].([]interface{}); ok && len(r) > 0 {
+			set = append(set, k)
+		}
+	}
+	if len(set) != 1 {
+		return nil, fmt.Errorf("%s: exactly one of `any`, `all`, `except` or `field` must be set", path)
+	}
+
+	switch op := set[0]; op {
+	case "any", "all":
+		children := rule[op].([]interface{})
+		expanded := make([]interface{}, len(children))
+		for i, child := range children {
+			r, err := expandRoleMappingRule(child, fmt.Sprintf("%s.%s.%d", path, op, i), op)
+			if err != nil {
+				return nil, err
+			}
+			expanded[i] = r
+		}
+		return map[string]interface{}{op: expanded}, nil
+	case "except":
+		if parent != "all" {
+			return nil, fmt.Errorf("%s: `except` is only allowed within `all`", path)
+		}
+		r, err := expandRoleMappingRule(rule["except"].([]interface{})[0], fmt.Sprintf("%s.except.0", path), "except")
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"except": r}, nil
+	default:
+		field, _ := rule["field"].([]interface{})[0].(map[string]interface{})
+		name, _ := field["name"].(string)
+		values, _ := field["values"].([]interface{})
+		if len(values) == 1 {
+			return map[string]interface{}{"field": map[string]interface{}{name: values[0]}}, nil
+		}
+		return map[string]interface{}{"field": map[string]interface{}{name: values}}, nil
+	}
+}
+
+// flattenRoleMappingRule returns false when the rules can't be represented by the rule block, e.g. they are nested too deep
+func flattenRoleMappingRule(rules map[string]interface{}, depth int) (map[string]interface{}, bool) {
+	if len(rules) != 1 {
+		return nil, false
+	}
+	for op, v := range rules {
+		switch op {
+		case "any", "all":
+			children, ok := v.([]interface{})
+			if !ok || depth >= roleMappingRuleMaxDepth {
+				return nil, false
+			}
+			flattened := make([]interface{}, len(children))
+			for i, child := range children {
+				c, ok := child.(map[string]interface{})
+				if !ok {
+					return nil, false
+				}
+				if flattened[i], ok = flattenRoleMappingRule(c, depth+1); !ok {
+					return nil, false
+				}
+			}
+			return map[string]interface{}{op: flattened}, true
+		case "except":
+			child, ok := v.(map[string]interface{})
+			if !ok || depth >= roleMappingRuleMaxDepth {
+				return nil, false
+			}
+			flattened, ok := flattenRoleMappingRule(child, depth+1)
+			if !ok {
+				return nil, false
+			}
+			return map[string]interface{}{"except": []interface{}{flattened}}, true
+		case "field":
+			field, ok := v.(map[string]interface{})
+			if !ok || len(field) != 1 {
+				return nil, false
+			}
+			for name, value := range field {
+				values := make([]interface{}, 0)
+				for _, fv := range fieldValues(value) {
+					if fv == nil {
+						return nil, false
+					}
+					values = append(values, fmt.Sprint(fv))
+				}
+				return map[string]interface{}{"field": []interface{}{map[string]interface{}{
+					"name":   name,
+					"values": values,
+				}}}, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// EvaluateRoleMappingRules tells whether the user matches the rules of a role mapping, the same way Elasticsearch does
+func EvaluateRoleMappingRules(rules map[string]interface{}, user *models.RoleMappingUser) (bool, error) {
+	if len(rules) != 1 {
+		return false, fmt.Errorf("a rule must have exactly one of `any`, `all`, `except` or `field`, got: %v", rules)
+	}
+	for op, v := range rules {
+		switch op {
+		case "any", "all":
+			children, ok := v.([]interface{})
+			if !ok {
+				return false, fmt.Errorf("`%s` must be a list of rules", op)
+			}
+			for _, child := range children {
+				c, ok := child.(map[string]interface{})
+				if !ok {
+					return false, fmt.Errorf("`%s` must be a list of rules", op)
+				}
+				matched, err := EvaluateRoleMappingRules(c, user)
+				if err != nil {
+					return false, err
+				}
+				if op == "any" && matched {
+					return true, nil
+				}
+				if op == "all" && !matched {
+					return false, nil
+				}
+			}
+			return op == "all", nil
+		case "except":
+			child, ok := v.(map[string]interface{})
+			if !ok {
+				return false, fmt.Errorf("`except` must be a rule")
+			}
+			matched, err := EvaluateRoleMappingRules(child, user)
+			return !matched, err
+		case "field":
+			field, ok := v.(map[string]interface{})
+			if !ok || len(field) != 1 {
+				return false, fmt.Errorf("`field` must have exactly one field, got: %v", v)
+			}
+			for name, value := range field {
+				return matchRoleMappingField(name, value, user)
+			}
+		default:
+			return false, fmt.Errorf("unknown rule `%s`", op)
+		}
+	}
+	return false, nil
+}
+
+func matchRoleMappingField(name string, value interface{}, user *models.RoleMappingUser) (bool, error) {
+	actual := roleMappingUserValues(name, user)
+	isDn := name == "dn" || name == "groups"
+	for _, expected := range fieldValues(value) {
+		if expected == nil {
+			if len(actual) == 0 {
+				return true, nil
+			}
+			continue
+		}
+		pattern, isString := expected.(string)
+		for _, a := range actual {
+			if !isString {
+				if fmt.Sprint(expected) == a {
+					return true, nil
+				}
+				continue
+			}
+			matched, err := matchRoleMappingValue(pattern, a, isDn)
+			if err != nil {
+				return false, fmt.Errorf("field `%s`: %w", name, err)
+			}
+			if matched {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func matchRoleMappingValue(pattern string, value string, isDn bool) (bool, error) {
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", pattern[1:len(pattern)-1]))
+		if err != nil {
+			return false, fmt.Errorf(`invalid regular expression "%s": %w`, pattern, err)
+		}
+		return re.MatchString(value), nil
+	}
+	if isDn {
+		pattern, value = normalizeDn(pattern), normalizeDn(value)
+	}
+	if strings.ContainsAny(pattern, "*?") {
+		wildcard := regexp.QuoteMeta(pattern)
+		wildcard = strings.ReplaceAll(wildcard, `\*`, ".*")
+		wildcard = strings.ReplaceAll(wildcard, `\?`, ".")
+		return regexp.MustCompile(fmt.Sprintf("^%s$", wildcard)).MatchString(value), nil
+	}
+	return pattern == value, nil
+}
+
+// normalizeDn makes the comparison of distinguished names case insensitive and ignores the spaces around the separators
+func normalizeDn(dn string) string {
+	parts := strings.Split(dn, ",")
+	for i, part := range parts {
+		if k, v, ok := strings.Cut(part, "="); ok {
+			parts[i] = strings.TrimSpace(k) + "=" + strings.TrimSpace(v)
+		} else {
+			parts[i] = strings.TrimSpace(part)
+		}
+	}
+	return strings.ToLower(strings.Join(parts, ","))
+}
+
+func roleMappingUserValues(name string, user *models.RoleMappingUser) []string {
+	nonEmpty := func(v string) []string {
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	}
+	switch name {
+	case "username":
+		return nonEmpty(user.Username)
+	case "dn":
+		return nonEmpty(user.Dn)
+	case "groups":
+		return user.Groups
+	case "realm.name":
+		return nonEmpty(user.Realm)
+	}
+	if key := strings.TrimPrefix(name, "metadata."); key != name {
+		values := make([]string, 0)
+		for _, v := range fieldValues(user.Metadata[key]) {
+			if v != nil {
+				values = append(values, fmt.Sprint(v))
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+func fieldValues(value interface{}) []interface{} {
+	if values, ok := value.([]interface{}); ok {
+		return values
+	}
+	return []interface{}{value}
+}
@@ -0,0 +1,121 @@
+package security_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/security"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+)
+
+func TestEvaluateRoleMappingRules(t *testing.T) {
+	t.Parallel()
+
+	user := models.RoleMappingUser{
+		Username: "jdoe",
+		Dn:       "cn=John Doe,ou=People,dc=example,dc=com",
+		Groups:   []string{"cn=admins,dc=example,dc=com", "cn=users,dc=example,dc=com"},
+		Realm:    "ldap1",
+		Metadata: map[string]interface{}{"department": "engineering"},
+	}
+
+	tests := []struct {
+		name    string
+		rules   string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:  "matches the username",
+			rules: `{"field":{"username":"jdoe"}}`,
+			want:  true,
+		},
+		{
+			name:  "matches any of the values",
+			rules: `{"field":{"username":["esadmin","jdoe"]}}`,
+			want:  true,
+		},
+		{
+			name:  "matches the dn case insensitively",
+			rules: `{"field":{"dn":"CN=John Doe, OU=People, DC=example, DC=com"}}`,
+			want:  true,
+		},
+		{
+			name:  "matches the dn with a wildcard",
+			rules: `{"field":{"dn":"*,ou=people,dc=example,dc=com"}}`,
+			want:  true,
+		},
+		{
+			name:  "matches the groups",
+			rules: `{"field":{"groups":"cn=admins,dc=example,dc=com"}}`,
+			want:  true,
+		},
+		{
+			name:  "matches a regular expression",
+			rules: `{"field":{"realm.name":"/ldap[0-9]+/"}}`,
+			want:  true,
+		},
+		{
+			name:  "matches the metadata",
+			rules: `{"field":{"metadata.department":"engineering"}}`,
+			want:  true,
+		},
+		{
+			name:  "matches the missing metadata with null",
+			rules: `{"field":{"metadata.location":null}}`,
+			want:  true,
+		},
+		{
+			name:  "does not match another realm",
+			rules: `{"field":{"realm.name":"saml1"}}`,
+			want:  false,
+		},
+		{
+			name:  "matches all the rules except one",
+			rules: `{"all":[{"field":{"realm.name":"ldap1"}},{"except":{"field":{"groups":"cn=contractors,dc=example,dc=com"}}}]}`,
+			want:  true,
+		},
+		{
+			name:  "does not match when the exception matches",
+			rules: `{"all":[{"field":{"realm.name":"ldap1"}},{"except":{"field":{"groups":"cn=admins,dc=example,dc=com"}}}]}`,
+			want:  false,
+		},
+		{
+			name:  "matches any of the rules",
+			rules: `{"any":[{"field":{"username":"esadmin"}},{"all":[{"field":{"realm.name":"ldap1"}},{"field":{"groups":"cn=users,*"}}]}]}`,
+			want:  true,
+		},
+		{
+			name:  "does not match an empty any",
+			rules: `{"any":[]}`,
+			want:  false,
+		},
+		{
+			name:    "fails on an unknown rule",
+			rules:   `{"none":[]}`,
+			wantErr: true,
+		},
+		{
+			name:    "fails on an invalid regular expression",
+			rules:   `{"field":{"username":"/[/"}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var rules map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.rules), &rules); err != nil {
+				t.Fatal(err)
+			}
+			got, err := security.EvaluateRoleMappingRules(rules, &user)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EvaluateRoleMappingRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("EvaluateRoleMappingRules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package security_test
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
@@ -54,6 +55,99 @@ func TestResourceRoleMapping(t *testing.T) {
 	})
 }
 
+func TestResourceRoleMappingRule(t *testing.T) {
+	roleMappingName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityRoleMappingDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSecurityRoleMappingRuleInvalid(roleMappingName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`except` is only allowed within `all`"),
+			},
+			{
+				Config: testAccResourceSecurityRoleMappingRule(roleMappingName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role_mapping.test", "name", roleMappingName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role_mapping.test", "rules", `{"all":[{"field":{"realm.name":"ldap1"}},{"any":[{"field":{"groups":["cn=admins,dc=example,dc=com","cn=ops,dc=example,dc=com"]}},{"field":{"username":"esadmin"}}]},{"except":{"field":{"metadata.contractor":"true"}}}]}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role_mapping.test", "rule.0.all.#", "3"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role_mapping.test", "rule.0.all.1.any.0.field.0.values.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityRoleMappingRuleInvalid(roleMappingName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role_mapping" "test" {
+  name  = "%s"
+  roles = ["admin"]
+
+  rule {
+    any {
+      except {
+        field {
+          name   = "username"
+          values = ["esadmin"]
+        }
+      }
+    }
+  }
+}
+	`, roleMappingName)
+}
+
+func testAccResourceSecurityRoleMappingRule(roleMappingName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role_mapping" "test" {
+  name  = "%s"
+  roles = ["admin"]
+
+  rule {
+    all {
+      field {
+        name   = "realm.name"
+        values = ["ldap1"]
+      }
+    }
+    all {
+      any {
+        field {
+          name   = "groups"
+          values = ["cn=admins,dc=example,dc=com", "cn=ops,dc=example,dc=com"]
+        }
+      }
+      any {
+        field {
+          name   = "username"
+          values = ["esadmin"]
+        }
+      }
+    }
+    all {
+      except {
+        field {
+          name   = "metadata.contractor"
+          values = ["true"]
+        }
+      }
+    }
+  }
+}
+	`, roleMappingName)
+}
+
 func testAccResourceSecurityRoleMappingCreate(roleMappingName string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -2,10 +2,13 @@ package security_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/security"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -102,6 +105,133 @@ resource "elasticstack_elasticsearch_security_role" "test" {
 	`, roleName)
 }
 
+func TestAccResourceSecurityRoleRemote(t *testing.T) {
+	roleName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityRoleDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.RoleRemoteIndicesMinVersion),
+				Config:   testAccResourceSecurityRoleRemoteIndices(roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "name", roleName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.#", "1"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.*.clusters.*", "remote-*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.*.names.*", "logs-*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.*.privileges.*", "read"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.*.field_security.0.grant.*", "message"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.RoleRemoteClusterMinVersion),
+				Config:   testAccResourceSecurityRoleRemoteCluster(roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "name", roleName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "description", "Cross-cluster search on the remote logs"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.#", "1"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_cluster.*.clusters.*", "remote-*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_cluster.*.privileges.*", "monitor_enrich"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_role.test", "description", "Cross-cluster search on the remote logs"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_role.test", "remote_indices.*.names.*", "logs-*"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_role.test", "remote_cluster.*.privileges.*", "monitor_enrich"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityRoleUnknownPrivileges(t *testing.T) {
+	roleName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityRoleDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSecurityRoleUnknownPrivileges(roleName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`cluster privilege "monitr", index privilege "raed"`),
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityRoleUnknownPrivileges(roleName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name    = "%s"
+  cluster = ["monitr", "cluster:monitor/main"]
+
+  indices {
+    names      = ["index1"]
+    privileges = ["raed", "indices:data/read/*"]
+  }
+}
+	`, roleName)
+}
+
+func testAccResourceSecurityRoleRemoteIndices(roleName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name = "%s"
+
+  remote_indices {
+    clusters   = ["remote-*"]
+    names      = ["logs-*"]
+    privileges = ["read", "read_cross_cluster"]
+
+    field_security {
+      grant = ["@timestamp", "message"]
+    }
+  }
+}
+	`, roleName)
+}
+
+func testAccResourceSecurityRoleRemoteCluster(roleName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name        = "%s"
+  description = "Cross-cluster search on the remote logs"
+
+  remote_indices {
+    clusters   = ["remote-*"]
+    names      = ["logs-*"]
+    privileges = ["read", "read_cross_cluster"]
+
+    field_security {
+      grant = ["@timestamp", "message"]
+    }
+  }
+
+  remote_cluster {
+    clusters   = ["remote-*"]
+    privileges = ["monitor_enrich"]
+  }
+}
+
+data "elasticstack_elasticsearch_security_role" "test" {
+  name = elasticstack_elasticsearch_security_role.test.name
+}
+	`, roleName)
+}
+
 func checkResourceSecurityRoleDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
@@ -0,0 +1,171 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceServiceAccounts() *schema.Resource {
+	accountsSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"namespace": {
+			Description: "Only returns the service accounts of the given namespace, e.g. `elastic`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"service": {
+			Description:  "Only returns the service account with the given name, e.g. `fleet-server`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"namespace"},
+		},
+		"service_accounts": {
+			Description: "The service accounts, sorted by principal.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"principal": {
+						Description: "The principal of the service account, e.g. `elastic/fleet-server`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"namespace": {
+						Description: "The namespace of the service account.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"service": {
+						Description: "The name of the service account.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"role_descriptor": {
+						Description: "The role descriptor of the service account, as JSON.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"credentials_count": {
+						Description: "The number of credentials of the service account, including the file tokens.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"tokens": {
+						Description: "The names of the service tokens stored in the cluster.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"file_tokens": {
+						Description: "The names of the service tokens defined in the `service_tokens` file of the nodes.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(accountsSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves the service accounts and their credentials. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-get-service-accounts.html",
+
+		ReadContext: dataSourceSecurityServiceAccountsRead,
+
+		Schema: accountsSchema,
+	}
+}
+
+func dataSourceSecurityServiceAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	if serverVersion.LessThan(ServiceAccountsMinVersion) {
+		return diag.Errorf("Service accounts are supported only for Elasticsearch v%s and above", ServiceAccountsMinVersion.String())
+	}
+
+	namespace := d.Get("namespace").(string)
+	service := d.Get("service").(string)
+	id, diags := client.ID(ctx, fmt.Sprintf("service-accounts:%s:%s", namespace, service))
+	if diags.HasError() {
+		return diags
+	}
+
+	accounts, diags := elasticsearch.GetServiceAccounts(ctx, client, namespace, service)
+	if diags.HasError() {
+		return diags
+	}
+	principals := make([]string, 0, len(accounts))
+	for principal := range accounts {
+		principals = append(principals, principal)
+	}
+	sort.Strings(principals)
+
+	result := make([]interface{}, len(principals))
+	for i, principal := range principals {
+		accountNamespace, accountService, _ := strings.Cut(principal, "/")
+		credentials, diags := elasticsearch.GetServiceCredentials(ctx, client, accountNamespace, accountService)
+		if diags.HasError() {
+			return diags
+		}
+		roleDescriptor, err := json.Marshal(accounts[principal].RoleDescriptor)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		account := map[string]interface{}{
+			"principal":         principal,
+			"namespace":         accountNamespace,
+			"service":           accountService,
+			"role_descriptor":   string(roleDescriptor),
+			"credentials_count": 0,
+			"tokens":            []string{},
+			"file_tokens":       []string{},
+		}
+		if credentials != nil {
+			account["credentials_count"] = credentials.Count
+			account["tokens"] = sortedKeys(credentials.Tokens)
+			account["file_tokens"] = sortedKeys(credentials.NodesCredentials.FileTokens)
+		}
+		result[i] = account
+	}
+	if err := d.Set("service_accounts", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,179 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var ServiceAccountsMinVersion = version.Must(version.NewVersion("7.13.0")) // Service accounts added in 7.13
+
+func ResourceServiceToken() *schema.Resource {
+	tokenSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"namespace": {
+			Description: "The namespace of the service account, e.g. `elastic`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"service": {
+			Description: "The name of the service account, e.g. `fleet-server`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the token. A name is generated when it is not set.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 256),
+				validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-][a-zA-Z0-9_-]*$`), "must contain only alphanumeric characters, `-` and `_`, and must not begin with `_`"),
+			),
+		},
+		"value": {
+			Description: "The value of the token, used as a bearer token, e.g. `Authorization: Bearer <value>`.",
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(tokenSchema)
+
+	return &schema.Resource{
+		Description: "Creates a token for a service account, and revokes it on destroy. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-create-service-token.html",
+
+		CreateContext: resourceSecurityServiceTokenCreate,
+		// only the connection can be updated
+		UpdateContext: resourceSecurityServiceTokenRead,
+		ReadContext:   resourceSecurityServiceTokenRead,
+		DeleteContext: resourceSecurityServiceTokenDelete,
+
+		Schema: tokenSchema,
+	}
+}
+
+func resourceSecurityServiceTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	if serverVersion.LessThan(ServiceAccountsMinVersion) {
+		return diag.Errorf("Service tokens are supported only for Elasticsearch v%s and above", ServiceAccountsMinVersion.String())
+	}
+
+	namespace := d.Get("namespace").(string)
+	service := d.Get("service").(string)
+	token, diags := elasticsearch.CreateServiceToken(ctx, client, namespace, service, d.Get("name").(string))
+	if diags.HasError() {
+		return diags
+	}
+
+	id, diags := client.ID(ctx, serviceTokenId(namespace, service, token.Name))
+	if diags.HasError() {
+		return diags
+	}
+
+	// the value is only returned on creation
+	if err := d.Set("value", token.Value); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return resourceSecurityServiceTokenRead(ctx, d, meta)
+}
+
+func resourceSecurityServiceTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	namespace, service, name, diags := serviceTokenFromId(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	credentials, diags := elasticsearch.GetServiceCredentials(ctx, client, namespace, service)
+	if diags.HasError() {
+		return diags
+	}
+	if credentials == nil || !hasServiceToken(credentials, name) {
+		tflog.Warn(ctx, fmt.Sprintf(`Service token "%s" of "%s/%s" not found, removing from state`, name, namespace, service))
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("namespace", namespace); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("service", service); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSecurityServiceTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	namespace, service, name, diags := serviceTokenFromId(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeleteServiceToken(ctx, client, namespace, service, name); diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+func hasServiceToken(credentials *models.ServiceCredentials, name string) bool {
+	_, ok := credentials.Tokens[name]
+	return ok
+}
+
+// the resource id of a service token is <namespace>:<service>:<name>, `:` is allowed in none of them
+func serviceTokenId(namespace, service, name string) string {
+	return fmt.Sprintf("%s:%s:%s", namespace, service, name)
+}
+
+func serviceTokenFromId(id string) (string, string, string, diag.Diagnostics) {
+	resourceId, diags := clients.ResourceIDFromStr(id)
+	if diags.HasError() {
+		return "", "", "", diags
+	}
+	parts := strings.Split(resourceId, ":")
+	if len(parts) != 3 {
+		return "", "", "", diag.Errorf("Wrong resource ID. Resource ID must have following format: <cluster_uuid>/<namespace>:<service>:<name>")
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,131 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/security"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSecurityServiceToken(t *testing.T) {
+	tokenName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityServiceTokenDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.ServiceAccountsMinVersion),
+				Config:   testAccResourceSecurityServiceToken(tokenName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_service_token.test", "namespace", "elastic"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_service_token.test", "service", "fleet-server"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_service_token.test", "name", tokenName),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_service_token.test", "value"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_service_token.generated", "name"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_service_token.generated", "value"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_service_accounts.test", "service_accounts.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_service_accounts.test", "service_accounts.0.principal", "elastic/fleet-server"),
+					resource.TestCheckTypeSetElemAttr("data.elasticstack_elasticsearch_security_service_accounts.test", "service_accounts.0.tokens.*", tokenName),
+				),
+			},
+			{
+				// removing the token from the configuration revokes it
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.ServiceAccountsMinVersion),
+				Config:   testAccResourceSecurityServiceTokenRemoved,
+				Check:    checkServiceTokenRevoked("elastic", "fleet-server", tokenName),
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityServiceToken(tokenName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_service_token" "test" {
+  namespace = "elastic"
+  service   = "fleet-server"
+  name      = "%s"
+}
+
+resource "elasticstack_elasticsearch_security_service_token" "generated" {
+  namespace = "elastic"
+  service   = "fleet-server"
+}
+
+data "elasticstack_elasticsearch_security_service_accounts" "test" {
+  namespace = "elastic"
+  service   = "fleet-server"
+
+  depends_on = [
+    elasticstack_elasticsearch_security_service_token.test,
+    elasticstack_elasticsearch_security_service_token.generated,
+  ]
+}
+	`, tokenName)
+}
+
+const testAccResourceSecurityServiceTokenRemoved = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_service_token" "generated" {
+  namespace = "elastic"
+  service   = "fleet-server"
+}
+`
+
+func checkResourceSecurityServiceTokenDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_security_service_token" {
+			continue
+		}
+		compId, diags := clients.CompositeIdFromStr(rs.Primary.ID)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to parse the id %s: %v", rs.Primary.ID, diags)
+		}
+		parts := strings.Split(compId.ResourceId, ":")
+		if len(parts) != 3 {
+			return fmt.Errorf("Unexpected service token id %s", compId.ResourceId)
+		}
+		if err := checkServiceTokenRevoked(parts[0], parts[1], parts[2])(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkServiceTokenRevoked(namespace, service, name string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingClient()
+		if err != nil {
+			return err
+		}
+
+		credentials, diags := elasticsearch.GetServiceCredentials(context.Background(), client, namespace, service)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get service credentials %v", diags)
+		}
+		if credentials == nil {
+			return fmt.Errorf("service account %s/%s not found", namespace, service)
+		}
+		if _, ok := credentials.Tokens[name]; ok {
+			return fmt.Errorf("service token (%s/%s/%s) still exists", namespace, service, name)
+		}
+		return nil
+	}
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
-	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -56,6 +55,7 @@ func ResourceSystemUser() *schema.Resource {
 		},
 	}
 
+	addUserPasswordSchema(userSchema)
 	utils.AddConnectionSchema(userSchema)
 
 	return &schema.Resource{
@@ -66,6 +66,8 @@ func ResourceSystemUser() *schema.Resource {
 		ReadContext:   resourceSecuritySystemUserRead,
 		DeleteContext: resourceSecuritySystemUserDelete,
 
+		CustomizeDiff: resourceSecurityUserPasswordCustomizeDiff,
+
 		Schema: userSchema,
 	}
 }
@@ -89,17 +91,12 @@ func resourceSecuritySystemUserPut(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf(`System user "%s" not found`, usernameId)
 	}
 
-	var userPassword models.UserPassword
-	if v, ok := d.GetOk("password"); ok && d.HasChange("password") {
-		password := v.(string)
-		userPassword.Password = &password
-	}
-	if v, ok := d.GetOk("password_hash"); ok && d.HasChange("password_hash") {
-		pass_hash := v.(string)
-		userPassword.PasswordHash = &pass_hash
+	userPassword, generated, diags := expandUserPassword(d)
+	if diags.HasError() {
+		return diags
 	}
-	if userPassword.Password != nil || userPassword.PasswordHash != nil {
-		if diags := elasticsearch.ChangeUserPassword(ctx, client, usernameId, &userPassword); diags.HasError() {
+	if userPassword != nil {
+		if diags := elasticsearch.ChangeUserPassword(ctx, client, usernameId, userPassword); diags.HasError() {
 			return diags
 		}
 	}
@@ -117,7 +114,11 @@ func resourceSecuritySystemUserPut(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(id.String())
-	return resourceSecuritySystemUserRead(ctx, d, meta)
+	diags = resourceSecuritySystemUserRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	return setGeneratedPassword(d, generated)
 }
 
 func resourceSecuritySystemUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -147,6 +148,10 @@ func resourceSecuritySystemUserRead(ctx context.Context, d *schema.ResourceData,
 	if err := d.Set("enabled", user.Enabled); err != nil {
 		return diag.FromErr(err)
 	}
+	// the generated password is only exposed by the apply generating it
+	if diags := clearGeneratedPassword(d); diags.HasError() {
+		return diags
+	}
 
 	return diags
 }
@@ -31,6 +31,23 @@ func TestAccResourceSecuritySystemUser(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecuritySystemUserGeneratedPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecuritySystemUserGeneratedPassword,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_system_user.remote_monitoring_user", "generated_password"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_system_user.remote_monitoring_user", "generated_password_hash"),
+					checkUserCanAuthenticateWithGeneratedPassword("elasticstack_elasticsearch_security_system_user.remote_monitoring_user", "remote_monitoring_user"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceSecuritySystemUserNotFound(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -65,6 +82,19 @@ resource "elasticstack_elasticsearch_security_system_user" "kibana_system" {
   enabled   = false
 }
 	`
+const testAccResourceSecuritySystemUserGeneratedPassword = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_system_user" "remote_monitoring_user" {
+  username = "remote_monitoring_user"
+
+  generate_password {
+    length = 24
+  }
+}
+	`
 const testAccResourceSecuritySystemUserNotFound = `
 provider "elasticstack" {
   elasticsearch {}
@@ -87,6 +87,7 @@ func ResourceUser() *schema.Resource {
 		},
 	}
 
+	addUserPasswordSchema(userSchema)
 	utils.AddConnectionSchema(userSchema)
 
 	return &schema.Resource{
@@ -97,6 +98,8 @@ func ResourceUser() *schema.Resource {
 		ReadContext:   resourceSecurityUserRead,
 		DeleteContext: resourceSecurityUserDelete,
 
+		CustomizeDiff: resourceSecurityUserPasswordCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -119,13 +122,15 @@ func resourceSecurityUserPut(ctx context.Context, d *schema.ResourceData, meta i
 	var user models.User
 	user.Username = usernameId
 
-	if v, ok := d.GetOk("password"); ok && d.HasChange("password") {
-		password := v.(string)
-		user.Password = &password
+	userPassword, generated, diags := expandUserPassword(d)
+	if diags.HasError() {
+		return diags
 	}
-	if v, ok := d.GetOk("password_hash"); ok && d.HasChange("password_hash") {
-		pass_hash := v.(string)
-		user.PasswordHash = &pass_hash
+	// the password of a new user is part of its creation, it is then changed through the dedicated API
+	isNew := d.Id() == ""
+	if userPassword != nil && isNew {
+		user.Password = userPassword.Password
+		user.PasswordHash = userPassword.PasswordHash
 	}
 
 	if v, ok := d.GetOk("email"); ok {
@@ -155,9 +160,18 @@ func resourceSecurityUserPut(ctx context.Context, d *schema.ResourceData, meta i
 	if diags := elasticsearch.PutUser(ctx, client, &user); diags.HasError() {
 		return diags
 	}
+	if userPassword != nil && !isNew {
+		if diags := elasticsearch.ChangeUserPassword(ctx, client, usernameId, userPassword); diags.HasError() {
+			return diags
+		}
+	}
 
 	d.SetId(id.String())
-	return resourceSecurityUserRead(ctx, d, meta)
+	diags = resourceSecurityUserRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	return setGeneratedPassword(d, generated)
 }
 
 func resourceSecurityUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -205,6 +219,10 @@ func resourceSecurityUserRead(ctx context.Context, d *schema.ResourceData, meta
 	if err := d.Set("enabled", user.Enabled); err != nil {
 		return diag.FromErr(err)
 	}
+	// the generated password is only exposed by the apply generating it
+	if diags := clearGeneratedPassword(d); diags.HasError() {
+		return diags
+	}
 
 	return diags
 }
@@ -0,0 +1,324 @@
+package security
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	passwordLowerCharacters   = "abcdefghijklmnopqrstuvwxyz"
+	passwordUpperCharacters   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordNumericCharacters = "0123456789"
+)
+
+// PasswordPolicy describes the passwords generated for the users
+type PasswordPolicy struct {
+	Length            int
+	MinLower          int
+	MinUpper          int
+	MinNumeric        int
+	MinSpecial        int
+	SpecialCharacters string
+}
+
+// addUserPasswordSchema adds the attributes generating, rotating and writing the password of a user without planning it
+func addUserPasswordSchema(userSchema map[string]*schema.Schema) {
+	userSchema["password"].ConflictsWith = []string{"password_hash", "password_wo", "generate_password"}
+	userSchema["password_hash"].ConflictsWith = []string{"password", "password_wo", "generate_password"}
+
+	userSchema["password_wo"] = &schema.Schema{
+		Description:   "The user’s password, which is not part of the plan. It is read from the configuration when applied, and only sent to Elasticsearch when `password_wo_version` changes. Passwords must be at least 6 characters long.",
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ValidateFunc:  validation.StringLenBetween(6, 128),
+		ConflictsWith: []string{"password", "password_hash", "generate_password"},
+		RequiredWith:  []string{"password_wo_version"},
+		// the value is read from the configuration when applied, and never planned
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return true
+		},
+	}
+	userSchema["password_wo_version"] = &schema.Schema{
+		Description:  "The version of `password_wo`. Change it to update the password of the user to the current value of `password_wo`.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"password_wo"},
+	}
+	userSchema["generate_password"] = &schema.Schema{
+		Description:   "Generates a random password for the user. The password is only exposed by `generated_password` and a new one is generated when the policy changes.",
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"password", "password_hash", "password_wo"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"length": {
+					Description:  "The length of the password.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      32,
+					ValidateFunc: validation.IntBetween(6, 128),
+				},
+				"min_lower": {
+					Description:  "The minimum number of lowercase letters.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"min_upper": {
+					Description:  "The minimum number of uppercase letters.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"min_numeric": {
+					Description:  "The minimum number of digits.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"min_special": {
+					Description:  "The minimum number of special characters.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"special_characters": {
+					Description:  "The special characters the password may contain. Set it to an empty string to generate passwords without special characters.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "!#$%&*()-_=+[]{}<>:?",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[[:graph:]]*$`), "must only contain printable characters of the Basic Latin (ASCII) block"),
+				},
+			},
+		},
+	}
+	userSchema["rotation_period"] = &schema.Schema{
+		Description:  "Period after which a new password is generated, e.g. `720h`. The change is planned by the first plan after the period has elapsed.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: utils.StringIsDuration,
+		RequiredWith: []string{"generate_password"},
+	}
+	userSchema["generated_password"] = &schema.Schema{
+		Description: "The generated password. It is only set by the apply generating it, and cleared from the state by the next refresh, after which only `generated_password_hash` is kept: consume it within the same apply, e.g. by storing it in a secret manager.",
+		Type:        schema.TypeString,
+		Sensitive:   true,
+		Computed:    true,
+	}
+	userSchema["generated_password_hash"] = &schema.Schema{
+		Description: "The SHA-256 hash of the generated password. It changes every time a new password is generated.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	userSchema["password_generated_at"] = &schema.Schema{
+		Description: "The time the password has been generated, in RFC 3339 format.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+}
+
+// resourceSecurityUserPasswordCustomizeDiff plans a new generated password when the policy changes or the rotation period has elapsed
+func resourceSecurityUserPasswordCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	generatedKeys := []string{"generated_password", "generated_password_hash", "password_generated_at"}
+	if _, ok := d.GetOk("generate_password"); !ok {
+		// the password is no longer generated
+		for _, k := range generatedKeys {
+			if d.Get(k).(string) != "" {
+				if err := d.SetNew(k, ""); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	regenerate := d.HasChange("generate_password")
+	if v, ok := d.GetOk("rotation_period"); ok && !regenerate {
+		period, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return err
+		}
+		generatedAt, err := time.Parse(time.RFC3339, d.Get("password_generated_at").(string))
+		regenerate = err != nil || time.Since(generatedAt) >= period
+	}
+	if !regenerate {
+		return nil
+	}
+	for _, k := range generatedKeys {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expandUserPassword returns the password to send to Elasticsearch, if any, and the password it has generated
+func expandUserPassword(d *schema.ResourceData) (*models.UserPassword, string, diag.Diagnostics) {
+	var userPassword models.UserPassword
+	if v, ok := d.GetOk("password"); ok && d.HasChange("password") {
+		password := v.(string)
+		userPassword.Password = &password
+	}
+	if v, ok := d.GetOk("password_hash"); ok && d.HasChange("password_hash") {
+		pass_hash := v.(string)
+		userPassword.PasswordHash = &pass_hash
+	}
+	if _, ok := d.GetOk("password_wo_version"); ok && d.HasChange("password_wo_version") {
+		// password_wo is not part of the plan, it must be read from the configuration
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return nil, "", diag.Errorf("Unable to read `password_wo` from the configuration")
+		}
+		passwordWo := config.GetAttr("password_wo")
+		if passwordWo.IsNull() || !passwordWo.IsKnown() {
+			return nil, "", diag.Errorf("`password_wo` must be known when `password_wo_version` changes")
+		}
+		password := passwordWo.AsString()
+		userPassword.Password = &password
+	}
+
+	// password_generated_at is unknown when a new password is planned by the rotation
+	if v, ok := d.GetOk("generate_password"); ok && d.HasChanges("generate_password", "password_generated_at") {
+		policy := v.([]interface{})[0].(map[string]interface{})
+		generated, err := GeneratePassword(PasswordPolicy{
+			Length:            policy["length"].(int),
+			MinLower:          policy["min_lower"].(int),
+			MinUpper:          policy["min_upper"].(int),
+			MinNumeric:        policy["min_numeric"].(int),
+			MinSpecial:        policy["min_special"].(int),
+			SpecialCharacters: policy["special_characters"].(string),
+		})
+		if err != nil {
+			return nil, "", diag.FromErr(err)
+		}
+		userPassword.Password = &generated
+		return &userPassword, generated, nil
+	}
+
+	if userPassword.Password == nil && userPassword.PasswordHash == nil {
+		return nil, "", nil
+	}
+	return &userPassword, "", nil
+}
+
+// setGeneratedPassword stores the hash of the generated password, the password itself is only kept until the next refresh
+func setGeneratedPassword(d *schema.ResourceData, generated string) diag.Diagnostics {
+	values := map[string]string{
+		"generated_password":      "",
+		"generated_password_hash": "",
+		"password_generated_at":   "",
+	}
+	if generated != "" {
+		hash := sha256.Sum256([]byte(generated))
+		values["generated_password"] = generated
+		values["generated_password_hash"] = hex.EncodeToString(hash[:])
+		values["password_generated_at"] = time.Now().UTC().Format(time.RFC3339)
+	} else if _, ok := d.GetOk("generate_password"); ok {
+		// the previous password is still in use
+		return nil
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// clearGeneratedPassword removes the password generated by a previous apply from the state, only its hash is kept
+func clearGeneratedPassword(d *schema.ResourceData) diag.Diagnostics {
+	// an unchanged empty value is not reported as a change by the refresh
+	if _, ok := d.GetOk("generated_password"); !ok {
+		return nil
+	}
+	if err := d.Set("generated_password", ""); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// GeneratePassword generates a random password meeting the policy
+func GeneratePassword(policy PasswordPolicy) (string, error) {
+	if policy.MinLower < 0 || policy.MinUpper < 0 || policy.MinNumeric < 0 || policy.MinSpecial < 0 {
+		return "", fmt.Errorf("the minimum numbers of characters must not be negative")
+	}
+	if required := policy.MinLower + policy.MinUpper + policy.MinNumeric + policy.MinSpecial; required > policy.Length {
+		return "", fmt.Errorf("the length of the password (%d) is less than the sum of the minimum numbers of characters (%d)", policy.Length, required)
+	}
+	if policy.MinSpecial > 0 && policy.SpecialCharacters == "" {
+		return "", fmt.Errorf("special characters are required but none is allowed")
+	}
+
+	password := make([]byte, 0, policy.Length)
+	for _, class := range []struct {
+		characters string
+		min        int
+	}{
+		{passwordLowerCharacters, policy.MinLower},
+		{passwordUpperCharacters, policy.MinUpper},
+		{passwordNumericCharacters, policy.MinNumeric},
+		{policy.SpecialCharacters, policy.MinSpecial},
+	} {
+		for i := 0; i < class.min; i++ {
+			c, err := randomCharacter(class.characters)
+			if err != nil {
+				return "", err
+			}
+			password = append(password, c)
+		}
+	}
+
+	all := strings.Join([]string{passwordLowerCharacters, passwordUpperCharacters, passwordNumericCharacters, policy.SpecialCharacters}, "")
+	for len(password) < policy.Length {
+		c, err := randomCharacter(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	// the characters required by the policy must not always come first
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+	return string(password), nil
+}
+
+func randomCharacter(characters string) (byte, error) {
+	i, err := randomInt(len(characters))
+	if err != nil {
+		return 0, err
+	}
+	return characters[i], nil
+}
+
+func randomInt(max int) (int, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(max)))
+	if err != nil {
+		return 0, err
+	}
+	return int(n.Int64()), nil
+}
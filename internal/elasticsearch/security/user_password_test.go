@@ -0,0 +1,79 @@
+package security_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/security"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		policy  security.PasswordPolicy
+		wantErr bool
+	}{
+		{
+			name:   "generates a password of the given length",
+			policy: security.PasswordPolicy{Length: 32, MinLower: 1, MinUpper: 1, MinNumeric: 1, MinSpecial: 1, SpecialCharacters: "!#$%"},
+		},
+		{
+			name:   "generates a password made of the required characters only",
+			policy: security.PasswordPolicy{Length: 8, MinLower: 2, MinUpper: 2, MinNumeric: 2, MinSpecial: 2, SpecialCharacters: "-"},
+		},
+		{
+			name:   "generates a password without special characters",
+			policy: security.PasswordPolicy{Length: 16, MinLower: 1, MinUpper: 1, MinNumeric: 1},
+		},
+		{
+			name:    "fails when the length is less than the required characters",
+			policy:  security.PasswordPolicy{Length: 6, MinLower: 2, MinUpper: 2, MinNumeric: 2, MinSpecial: 2, SpecialCharacters: "-"},
+			wantErr: true,
+		},
+		{
+			name:    "fails when special characters are required but none is allowed",
+			policy:  security.PasswordPolicy{Length: 16, MinSpecial: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			password, err := security.GeneratePassword(tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GeneratePassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(password) != tt.policy.Length {
+				t.Errorf("GeneratePassword() length = %d, want %d", len(password), tt.policy.Length)
+			}
+			for _, class := range []struct {
+				characters string
+				min        int
+			}{
+				{"abcdefghijklmnopqrstuvwxyz", tt.policy.MinLower},
+				{"ABCDEFGHIJKLMNOPQRSTUVWXYZ", tt.policy.MinUpper},
+				{"0123456789", tt.policy.MinNumeric},
+				{tt.policy.SpecialCharacters, tt.policy.MinSpecial},
+			} {
+				count := 0
+				for _, c := range password {
+					if strings.ContainsRune(class.characters, c) {
+						count++
+					}
+				}
+				if count < class.min {
+					t.Errorf("GeneratePassword() = %s, want at least %d of %q", password, class.min, class.characters)
+				}
+			}
+			if tt.policy.SpecialCharacters == "" && strings.ContainsAny(password, "!#$%&*()-_=+[]{}<>:?") {
+				t.Errorf("GeneratePassword() = %s, want no special characters", password)
+			}
+		})
+	}
+}
@@ -153,6 +153,94 @@ func TestAccImportedUserDoesNotResetPassword(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecurityUserGeneratedPassword(t *testing.T) {
+	username := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	var passwordHash string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityUserDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserGeneratedPassword(username, 32),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_user.test", "generated_password", func(value string) error {
+						if len(value) != 32 {
+							return fmt.Errorf("expected a password of 32 characters, got %d", len(value))
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_user.test", "generated_password_hash", func(value string) error {
+						passwordHash = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_user.test", "password_generated_at"),
+					checkUserCanAuthenticateWithGeneratedPassword("elasticstack_elasticsearch_security_user.test", username),
+				),
+			},
+			{
+				Config: testAccResourceSecurityUserGeneratedPassword(username, 40),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_user.test", "generated_password_hash", func(value string) error {
+						if value == passwordHash {
+							return fmt.Errorf("expected a new password to be generated")
+						}
+						return nil
+					}),
+					checkUserCanAuthenticateWithGeneratedPassword("elasticstack_elasticsearch_security_user.test", username),
+				),
+			},
+			{
+				// only the hash of the generated password is kept by the refresh
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "generated_password", ""),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_user.test", "generated_password_hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityUserWriteOnlyPassword(t *testing.T) {
+	username := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityUserDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserWriteOnlyPassword(username, "qwerty123", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("elasticstack_elasticsearch_security_user.test", "password_wo"),
+					checkUserCanAuthenticate(username, "qwerty123"),
+				),
+			},
+			{
+				// the password is not changed until the version changes
+				Config: testAccResourceSecurityUserWriteOnlyPassword(username, "qwerty456", "1"),
+				Check:  checkUserCanAuthenticate(username, "qwerty123"),
+			},
+			{
+				Config: testAccResourceSecurityUserWriteOnlyPassword(username, "qwerty456", "2"),
+				Check:  checkUserCanAuthenticate(username, "qwerty456"),
+			},
+		},
+	})
+}
+
+func checkUserCanAuthenticateWithGeneratedPassword(resourceName string, username string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found", resourceName)
+		}
+		return checkUserCanAuthenticate(username, rs.Primary.Attributes["generated_password"])(s)
+	}
+}
+
 func checkUserCanAuthenticate(username string, password string) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		client, err := clients.NewAcceptanceTestingClient()
@@ -225,6 +313,40 @@ resource "elasticstack_elasticsearch_security_user" "test" {
 	`, username, role)
 }
 
+func testAccResourceSecurityUserGeneratedPassword(username string, length int) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_user" "test" {
+  username = "%s"
+  roles    = ["kibana_user"]
+
+  generate_password {
+    length      = %d
+    min_special = 2
+  }
+  rotation_period = "720h"
+}
+	`, username, length)
+}
+
+func testAccResourceSecurityUserWriteOnlyPassword(username string, password string, version string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_user" "test" {
+  username            = "%s"
+  roles               = ["kibana_user"]
+  password_wo         = "%s"
+  password_wo_version = "%s"
+}
+	`, username, password, version)
+}
+
 func checkResourceSecurityUserDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
@@ -0,0 +1,683 @@
+package transform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type settingOptions struct {
+	def        interface{}
+	minVersion *version.Version
+	// the default is set by the cluster, def is only the zero value of the attribute
+	dynamicDefault bool
+}
+
+// Settings which can be configured in the `settings` block, with their ES defaults and the version they were introduced in
+var transformSettings = map[string]settingOptions{
+	"align_checkpoints":     {def: true, minVersion: version.Must(version.NewVersion("8.2.0"))},
+	"dates_as_epoch_millis": {def: false, minVersion: version.Must(version.NewVersion("7.11.0"))},
+	"deduce_mappings":       {def: true, minVersion: version.Must(version.NewVersion("8.1.0"))},
+	"docs_per_second":       {def: 0.0, dynamicDefault: true},
+	"max_page_search_size":  {def: 500},
+	"num_failure_retries":   {def: 0, minVersion: version.Must(version.NewVersion("8.4.0")), dynamicDefault: true},
+	"unattended":            {def: false, minVersion: version.Must(version.NewVersion("8.5.0"))},
+}
+
+func ResourceTransform() *schema.Resource {
+	transformSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Identifier for the transform. It can contain lowercase alphanumeric characters (a-z and 0-9), hyphens, and underscores. It must start and end with alphanumeric characters.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 64),
+				validation.StringMatch(regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]*[a-z0-9])?$`), "must contain lowercase alphanumeric characters (a-z and 0-9), hyphens, and underscores, and must start and end with alphanumeric characters"),
+			),
+		},
+		"description": {
+			Description: "Free text description of the transform.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"source": {
+			Description: "The source of the data for the transform.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"indices": {
+						Description: "The source indices for the transform.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"query": {
+						Description:      "A query clause that retrieves a subset of data from the source index.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						Default:          `{"match_all":{}}`,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+					},
+					"runtime_mappings": {
+						Description:      "Definitions of search-time runtime fields that can be used by the transform.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+					},
+				},
+			},
+		},
+		"dest": {
+			Description: "The destination for the transform.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"index": {
+						Description: "The destination index for the transform.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"pipeline": {
+						Description: "The unique identifier for an ingest pipeline.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"pivot": {
+			Description:      "The pivot method transforms the data by aggregating and grouping it. Changing this field forces a new transform to be created.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ExactlyOneOf:     []string{"pivot", "latest"},
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"latest": {
+			Description:      "The latest method transforms the data by finding the latest document for each unique key. Changing this field forces a new transform to be created.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ExactlyOneOf:     []string{"pivot", "latest"},
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"frequency": {
+			Description:  "The interval between checks for changes in the source indices when the transform is running continuously. The minimum value is `1s` and the maximum is `1h`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "1m",
+			ValidateFunc: utils.StringIsDuration,
+		},
+		"sync": {
+			Description: "Defines the properties transforms require to run continuously. Adding or removing this block forces a new transform to be created.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"time": {
+						Description: "Specifies that the transform uses a time field to synchronize the source and destination indices.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field": {
+									Description: "The date field that is used to identify new documents in the source.",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+								"delay": {
+									Description: "The time delay between the current time and the latest input data time.",
+									Type:        schema.TypeString,
+									Optional:    true,
+									Default:     "60s",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"retention_policy": {
+			Description: "Defines a retention policy for the transform. Data that meets the defined criteria is deleted from the destination index. Adding or removing this block forces a new transform to be created.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"time": {
+						Description: "Specifies that the transform uses a time field to set the retention policy.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field": {
+									Description: "The date field that is used to calculate the age of the document.",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+								"max_age": {
+									Description: "Specifies the maximum age of a document in the destination index.",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"settings": {
+			Description: "Defines optional transform settings. The settings removed from the configuration are reset to their defaults.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"align_checkpoints": {
+						Description: "Specifies whether the transform checkpoint ranges should be optimized for performance. Supported from Elasticsearch version **8.2**",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+					"dates_as_epoch_millis": {
+						Description: "Defines if dates in the output should be written as ISO formatted string or as millis since epoch. Supported from Elasticsearch version **7.11**",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"deduce_mappings": {
+						Description: "Specifies whether the transform should deduce the destination index mappings from the transform configuration. Supported from Elasticsearch version **8.1**",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+					"docs_per_second": {
+						Description:  "Specifies a limit on the number of input documents per second. By default there is no throttling.",
+						Type:         schema.TypeFloat,
+						Optional:     true,
+						ValidateFunc: validation.FloatAtLeast(0),
+					},
+					"max_page_search_size": {
+						Description:  "Defines the initial page size to use for the composite aggregation for each checkpoint.",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      500,
+						ValidateFunc: validation.IntBetween(10, 65536),
+					},
+					"num_failure_retries": {
+						Description:  "Defines the number of retries on a recoverable failure before the transform task is marked as failed. `-1` means infinite retries. Supported from Elasticsearch version **8.4**",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(-1, 100),
+					},
+					"unattended": {
+						Description: "If `true`, the transform runs in unattended mode. Supported from Elasticsearch version **8.5**",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+		"metadata": {
+			Description:      "Defines optional transform metadata.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"defer_validation": {
+			Description: "When `true`, deferrable validations are not run. This behavior may be desired if the source index does not exist until after the transform is created.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"enabled": {
+			Description: "Controls whether the transform should be started or stopped.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"state": {
+			Description: "The current state of the transform, e.g. `started`, `indexing` or `stopped`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"health": {
+			Description: "The health status of the transform. Reported from Elasticsearch version **8.6**",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(transformSchema)
+
+	return &schema.Resource{
+		Description: "Creates, updates, starts and stops a transform. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/transforms.html",
+
+		CreateContext: resourceTransformCreate,
+		UpdateContext: resourceTransformUpdate,
+		ReadContext:   resourceTransformRead,
+		DeleteContext: resourceTransformDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		// the update API cannot turn a batch transform into a continuous one (and vice versa), nor drop the retention policy
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("sync", blockPresenceChanged),
+			customdiff.ForceNewIfChange("retention_policy", blockPresenceChanged),
+		),
+
+		Schema: transformSchema,
+	}
+}
+
+func blockPresenceChanged(ctx context.Context, old, new, meta interface{}) bool {
+	return len(old.([]interface{})) != len(new.([]interface{}))
+}
+
+func resourceTransformCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	transformName := d.Get("name").(string)
+	id, diags := client.ID(ctx, transformName)
+	if diags.HasError() {
+		return diags
+	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	transform, diags := expandTransform(d, serverVersion)
+	if diags.HasError() {
+		return diags
+	}
+	if diags := elasticsearch.PutTransform(ctx, client, transform, d.Get("defer_validation").(bool)); diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if d.Get("enabled").(bool) {
+		if diags := elasticsearch.StartTransform(ctx, client, transformName); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceTransformRead(ctx, d, meta)
+}
+
+func resourceTransformUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if d.HasChanges("description", "source", "dest", "frequency", "sync", "retention_policy", "settings", "metadata") {
+		transform, diags := expandTransform(d, serverVersion)
+		if diags.HasError() {
+			return diags
+		}
+		if diags := elasticsearch.UpdateTransform(ctx, client, transform, d.Get("defer_validation").(bool)); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if d.Get("enabled").(bool) {
+			if diags := elasticsearch.StartTransform(ctx, client, compId.ResourceId); diags.HasError() {
+				return diags
+			}
+		} else {
+			if diags := elasticsearch.StopTransform(ctx, client, compId.ResourceId); diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return resourceTransformRead(ctx, d, meta)
+}
+
+// isSettingConfigured tells a setting configured with its zero value, e.g. `num_failure_retries = 0`, apart from an unset one
+func isSettingConfigured(d *schema.ResourceData, k string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	settings := config.GetAttr("settings")
+	if settings.IsNull() || !settings.IsKnown() || settings.LengthInt() == 0 {
+		return false
+	}
+	return !settings.Index(cty.NumberIntVal(0)).GetAttr(k).IsNull()
+}
+
+func expandTransform(d *schema.ResourceData, serverVersion *version.Version) (*models.Transform, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	transform := models.Transform{
+		Id:          d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Frequency:   d.Get("frequency").(string),
+	}
+
+	source := d.Get("source").([]interface{})[0].(map[string]interface{})
+	transform.Source = &models.TransformSource{}
+	for _, i := range source["indices"].([]interface{}) {
+		transform.Source.Indices = append(transform.Source.Indices, i.(string))
+	}
+	if v := source["query"].(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &transform.Source.Query); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+	if v := source["runtime_mappings"].(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &transform.Source.RuntimeMappings); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	dest := d.Get("dest").([]interface{})[0].(map[string]interface{})
+	transform.Destination = &models.TransformDestination{
+		Index:    dest["index"].(string),
+		Pipeline: dest["pipeline"].(string),
+	}
+
+	if v, ok := d.GetOk("pivot"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &transform.Pivot); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+	if v, ok := d.GetOk("latest"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &transform.Latest); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOk("sync"); ok {
+		timeSync := v.([]interface{})[0].(map[string]interface{})["time"].([]interface{})[0].(map[string]interface{})
+		transform.Sync = &models.TransformSync{
+			Time: &models.TransformTimeSync{
+				Field: timeSync["field"].(string),
+				Delay: timeSync["delay"].(string),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("retention_policy"); ok {
+		timeRetention := v.([]interface{})[0].(map[string]interface{})["time"].([]interface{})[0].(map[string]interface{})
+		transform.RetentionPolicy = &models.TransformRetentionPolicy{
+			Time: &models.TransformTimeRetentionPolicy{
+				Field:  timeRetention["field"].(string),
+				MaxAge: timeRetention["max_age"].(string),
+			},
+		}
+	}
+
+	settings := make(map[string]interface{})
+	if v, ok := d.GetOk("settings"); ok && v.([]interface{})[0] != nil {
+		for k, value := range v.([]interface{})[0].(map[string]interface{}) {
+			options := transformSettings[k]
+			isDefault := value == options.def
+			if options.minVersion != nil && options.minVersion.GreaterThan(serverVersion) {
+				if !isDefault {
+					return nil, diag.Errorf("[%s] is not supported in the target Elasticsearch server. Remove the setting from your transform definition or set it to the default [%v] value", k, options.def)
+				}
+				continue
+			}
+			// settings without a static default are only sent when configured, their zero value included
+			if options.dynamicDefault && !isSettingConfigured(d, k) {
+				continue
+			}
+			settings[k] = value
+		}
+	}
+	// the settings removed from the configuration are reset to their defaults with explicit nulls, omitting them keeps them unchanged
+	if old, _ := d.GetChange("settings"); len(old.([]interface{})) > 0 && old.([]interface{})[0] != nil {
+		for k, value := range old.([]interface{})[0].(map[string]interface{}) {
+			options := transformSettings[k]
+			// the zero value of the settings without a static default may have been configured
+			if _, ok := settings[k]; ok || (value == options.def && !options.dynamicDefault) {
+				continue
+			}
+			if options.minVersion != nil && options.minVersion.GreaterThan(serverVersion) {
+				continue
+			}
+			settings[k] = nil
+		}
+	}
+	if len(settings) > 0 {
+		transform.Settings = settings
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &transform.Meta); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	return &transform, diags
+}
+
+func resourceTransformRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	transformName := compId.ResourceId
+
+	transform, diags := elasticsearch.GetTransform(ctx, client, transformName)
+	if transform == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Transform "%s" not found, removing from state`, transformName))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("name", transformName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", transform.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if transform.Frequency != "" {
+		if err := d.Set("frequency", transform.Frequency); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if s := transform.Source; s != nil {
+		source := map[string]interface{}{
+			"indices": s.Indices,
+		}
+		if s.Query != nil {
+			query, err := json.Marshal(s.Query)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			source["query"] = string(query)
+		}
+		if s.RuntimeMappings != nil {
+			runtimeMappings, err := json.Marshal(s.RuntimeMappings)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			source["runtime_mappings"] = string(runtimeMappings)
+		}
+		if err := d.Set("source", []interface{}{source}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if dst := transform.Destination; dst != nil {
+		dest := map[string]interface{}{
+			"index":    dst.Index,
+			"pipeline": dst.Pipeline,
+		}
+		if err := d.Set("dest", []interface{}{dest}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if transform.Pivot != nil {
+		pivot, err := json.Marshal(transform.Pivot)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("pivot", string(pivot)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if transform.Latest != nil {
+		latest, err := json.Marshal(transform.Latest)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("latest", string(latest)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	sync := make([]interface{}, 0)
+	if transform.Sync != nil && transform.Sync.Time != nil {
+		sync = append(sync, map[string]interface{}{
+			"time": []interface{}{map[string]interface{}{
+				"field": transform.Sync.Time.Field,
+				"delay": transform.Sync.Time.Delay,
+			}},
+		})
+	}
+	if err := d.Set("sync", sync); err != nil {
+		return diag.FromErr(err)
+	}
+
+	retentionPolicy := make([]interface{}, 0)
+	if transform.RetentionPolicy != nil && transform.RetentionPolicy.Time != nil {
+		retentionPolicy = append(retentionPolicy, map[string]interface{}{
+			"time": []interface{}{map[string]interface{}{
+				"field":   transform.RetentionPolicy.Time.Field,
+				"max_age": transform.RetentionPolicy.Time.MaxAge,
+			}},
+		})
+	}
+	if err := d.Set("retention_policy", retentionPolicy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// only track the settings block if it's configured or the transform has explicit settings
+	if _, ok := d.GetOk("settings"); ok || len(transform.Settings) > 0 {
+		settings := make(map[string]interface{})
+		for k, options := range transformSettings {
+			if v, ok := transform.Settings[k]; ok {
+				settings[k] = v
+			} else {
+				settings[k] = options.def
+			}
+		}
+		if err := d.Set("settings", []interface{}{settings}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if transform.Meta != nil {
+		metadata, err := json.Marshal(transform.Meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("metadata", string(metadata)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	stats, diags := elasticsearch.GetTransformStats(ctx, client, transformName)
+	if diags.HasError() {
+		return diags
+	}
+	if stats != nil {
+		if err := d.Set("state", stats.State); err != nil {
+			return diag.FromErr(err)
+		}
+		if stats.Health != nil {
+			if err := d.Set("health", stats.Health.Status); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		// batch transforms stop on their own once they are done, so only reflect the state of continuous transforms
+		if transform.Sync != nil {
+			if err := d.Set("enabled", stats.State != "stopped"); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return diags
+}
+
+func resourceTransformDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeleteTransform(ctx, client, compId.ResourceId); diags.HasError() {
+		return diags
+	}
+	return diags
+}
@@ -0,0 +1,382 @@
+package transform_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceTransform(t *testing.T) {
+	// generate a random name
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceTransformDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTransformCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "name", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "description", "test transform"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "source.0.indices.0", fmt.Sprintf("%s-source", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "dest.0.index", fmt.Sprintf("%s-dest", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "frequency", "1m"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "sync.0.time.0.field", "@timestamp"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "state", "stopped"),
+				),
+			},
+			{
+				Config: testAccResourceTransformUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "name", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "description", "updated test transform"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "frequency", "5m"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "sync.0.time.0.delay", "120s"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "settings.0.max_page_search_size", "1000"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccResourceTransformRemoveSettings(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "settings.#", "0"),
+					checkResourceTransformHasNoSettings(name),
+				),
+			},
+		},
+	})
+}
+
+var transformNumFailureRetriesMinVersion = version.Must(version.NewVersion("8.4.0"))
+
+func TestAccResourceTransformNoRetries(t *testing.T) {
+	// generate a random name
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceTransformDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				// 0 disables the retries, unlike an unset value which defaults to the cluster setting
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(transformNumFailureRetriesMinVersion),
+				Config:   testAccResourceTransformNoRetries(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_transform.test", "settings.0.num_failure_retries", "0"),
+					checkResourceTransformSetting(name, "num_failure_retries", float64(0)),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceTransformCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "source" {
+  name = "%s-source"
+
+  mappings = jsonencode({
+    properties = {
+      "@timestamp" = { type = "date" }
+      customer_id  = { type = "keyword" }
+      total        = { type = "double" }
+    }
+  })
+}
+
+resource "elasticstack_elasticsearch_transform" "test" {
+  name        = "%s"
+  description = "test transform"
+
+  source {
+    indices = [elasticstack_elasticsearch_index.source.name]
+  }
+
+  dest {
+    index = "%s-dest"
+  }
+
+  pivot = jsonencode({
+    group_by = {
+      customer_id = {
+        terms = {
+          field = "customer_id"
+        }
+      }
+    }
+    aggregations = {
+      total_spend = {
+        sum = {
+          field = "total"
+        }
+      }
+    }
+  })
+
+  sync {
+    time {
+      field = "@timestamp"
+    }
+  }
+}
+	`, name, name, name)
+}
+
+func testAccResourceTransformUpdate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "source" {
+  name = "%s-source"
+
+  mappings = jsonencode({
+    properties = {
+      "@timestamp" = { type = "date" }
+      customer_id  = { type = "keyword" }
+      total        = { type = "double" }
+    }
+  })
+}
+
+resource "elasticstack_elasticsearch_transform" "test" {
+  name        = "%s"
+  description = "updated test transform"
+
+  source {
+    indices = [elasticstack_elasticsearch_index.source.name]
+  }
+
+  dest {
+    index = "%s-dest"
+  }
+
+  pivot = jsonencode({
+    group_by = {
+      customer_id = {
+        terms = {
+          field = "customer_id"
+        }
+      }
+    }
+    aggregations = {
+      total_spend = {
+        sum = {
+          field = "total"
+        }
+      }
+    }
+  })
+
+  frequency = "5m"
+
+  sync {
+    time {
+      field = "@timestamp"
+      delay = "120s"
+    }
+  }
+
+  settings {
+    max_page_search_size = 1000
+  }
+
+  enabled = true
+}
+	`, name, name, name)
+}
+
+func testAccResourceTransformRemoveSettings(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "source" {
+  name = "%s-source"
+
+  mappings = jsonencode({
+    properties = {
+      "@timestamp" = { type = "date" }
+      customer_id  = { type = "keyword" }
+      total        = { type = "double" }
+    }
+  })
+}
+
+resource "elasticstack_elasticsearch_transform" "test" {
+  name        = "%s"
+  description = "updated test transform"
+
+  source {
+    indices = [elasticstack_elasticsearch_index.source.name]
+  }
+
+  dest {
+    index = "%s-dest"
+  }
+
+  pivot = jsonencode({
+    group_by = {
+      customer_id = {
+        terms = {
+          field = "customer_id"
+        }
+      }
+    }
+    aggregations = {
+      total_spend = {
+        sum = {
+          field = "total"
+        }
+      }
+    }
+  })
+
+  frequency = "5m"
+
+  sync {
+    time {
+      field = "@timestamp"
+      delay = "120s"
+    }
+  }
+
+  enabled = true
+}
+	`, name, name, name)
+}
+
+func testAccResourceTransformNoRetries(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "source" {
+  name = "%[1]s-source"
+
+  mappings = jsonencode({
+    properties = {
+      "@timestamp" = { type = "date" }
+      customer_id  = { type = "keyword" }
+      total        = { type = "double" }
+    }
+  })
+}
+
+resource "elasticstack_elasticsearch_transform" "test" {
+  name = "%[1]s"
+
+  source {
+    indices = [elasticstack_elasticsearch_index.source.name]
+  }
+
+  dest {
+    index = "%[1]s-dest"
+  }
+
+  pivot = jsonencode({
+    group_by = {
+      customer_id = {
+        terms = {
+          field = "customer_id"
+        }
+      }
+    }
+    aggregations = {
+      total_spend = {
+        sum = {
+          field = "total"
+        }
+      }
+    }
+  })
+
+  settings {
+    num_failure_retries = 0
+  }
+}
+	`, name)
+}
+
+func checkResourceTransformSetting(name string, setting string, value interface{}) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingClient()
+		if err != nil {
+			return err
+		}
+		transform, diags := elasticsearch.GetTransform(context.Background(), client, name)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get transform %v", diags)
+		}
+		if transform == nil {
+			return fmt.Errorf("Transform (%s) not found", name)
+		}
+		if v, ok := transform.Settings[setting]; !ok || v != value {
+			return fmt.Errorf("Transform (%s) has %s = %v, expected %v", name, setting, v, value)
+		}
+		return nil
+	}
+}
+
+func checkResourceTransformHasNoSettings(name string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingClient()
+		if err != nil {
+			return err
+		}
+		transform, diags := elasticsearch.GetTransform(context.Background(), client, name)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get transform %v", diags)
+		}
+		if transform == nil {
+			return fmt.Errorf("Transform (%s) not found", name)
+		}
+		if _, ok := transform.Settings["max_page_search_size"]; ok {
+			return fmt.Errorf("Transform (%s) still has settings: %v", name, transform.Settings)
+		}
+		return nil
+	}
+}
+
+func checkResourceTransformDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_transform" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+
+		transform, diags := elasticsearch.GetTransform(context.Background(), client, compId.ResourceId)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get transform %v", diags)
+		}
+		if transform != nil {
+			return fmt.Errorf("Transform (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
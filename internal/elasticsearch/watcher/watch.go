@@ -0,0 +1,342 @@
+package watcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// the JSON attributes of the watch, in the order they appear in the watch definition
+var watchJSONFields = []string{"trigger", "input", "condition", "actions", "transform", "metadata"}
+
+func ResourceWatch() *schema.Resource {
+	watchSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"watch_id": {
+			Description: "Identifier for the watch.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"active": {
+			Description: "Defines whether the watch is active or inactive.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"trigger": {
+			Description:      "The trigger that defines when the watch should run, as a JSON object.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"input": {
+			Description:      "The input that defines the input that loads the data for the watch, as a JSON object.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          `{"none":{}}`,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"condition": {
+			Description:      "The condition that defines if the actions should be run, as a JSON object.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          `{"always":{}}`,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"actions": {
+			Description:      "The list of actions that will be run if the condition matches, as a JSON object.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "{}",
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"transform": {
+			Description:      "Processes the watch payload to prepare it for the watch actions, as a JSON object.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"metadata": {
+			Description:      "Metadata json that will be copied into the history entries.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"throttle_period": {
+			Description:      "Minimum time between actions being run, e.g. `5m` or `1h`. Defaults to 5 seconds on the cluster.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringMatch(timeValueRegexp, "must be a valid time value, e.g. `30s`, `1.5h` or `500ms`"),
+			DiffSuppressFunc: diffTimeValueSuppress,
+		},
+	}
+
+	utils.AddConnectionSchema(watchSchema)
+
+	return &schema.Resource{
+		Description: "Adds and manages a Watch. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/watcher-api-put-watch.html",
+
+		CreateContext: resourceWatchPut,
+		UpdateContext: resourceWatchUpdate,
+		ReadContext:   resourceWatchRead,
+		DeleteContext: resourceWatchDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: watchSchema,
+	}
+}
+
+func resourceWatchPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	watchID := d.Get("watch_id").(string)
+	id, diags := client.ID(ctx, watchID)
+	if diags.HasError() {
+		return diags
+	}
+
+	watch, diags := expandWatch(d)
+	if diags.HasError() {
+		return diags
+	}
+	if diags := elasticsearch.PutWatch(ctx, client, watch); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceWatchRead(ctx, d, meta)
+}
+
+func resourceWatchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the put watch API also sets the active state, so only use the dedicated APIs if nothing else changed
+	if d.HasChanges(append(watchJSONFields, "throttle_period")...) {
+		return resourceWatchPut(ctx, d, meta)
+	}
+
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	if d.HasChange("active") {
+		if d.Get("active").(bool) {
+			if diags := elasticsearch.ActivateWatch(ctx, client, compId.ResourceId); diags.HasError() {
+				return diags
+			}
+		} else {
+			if diags := elasticsearch.DeactivateWatch(ctx, client, compId.ResourceId); diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return resourceWatchRead(ctx, d, meta)
+}
+
+func expandWatch(d *schema.ResourceData) (*models.Watch, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	watch := models.Watch{
+		WatchID:        d.Get("watch_id").(string),
+		Active:         d.Get("active").(bool),
+		ThrottlePeriod: d.Get("throttle_period").(string),
+	}
+
+	fields := map[string]*map[string]interface{}{
+		"trigger":   &watch.Trigger,
+		"input":     &watch.Input,
+		"condition": &watch.Condition,
+		"actions":   &watch.Actions,
+		"transform": &watch.Transform,
+		"metadata":  &watch.Metadata,
+	}
+	for _, key := range watchJSONFields {
+		v, ok := d.GetOk(key)
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal([]byte(v.(string)), fields[key]); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to parse %s", key),
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return &watch, diags
+}
+
+func resourceWatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	watchID := compId.ResourceId
+
+	watch, diags := elasticsearch.GetWatch(ctx, client, watchID)
+	if watch == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Watch "%s" not found, removing from state`, watchID))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("watch_id", watchID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("active", watch.Active); err != nil {
+		return diag.FromErr(err)
+	}
+
+	normalizeSearchInput(watch.Input)
+	fields := map[string]map[string]interface{}{
+		"trigger":   watch.Trigger,
+		"input":     watch.Input,
+		"condition": watch.Condition,
+		"actions":   watch.Actions,
+		"transform": watch.Transform,
+		"metadata":  watch.Metadata,
+	}
+	for _, key := range watchJSONFields {
+		v := fields[key]
+		if v == nil {
+			if err := d.Set(key, nil); err != nil {
+				return diag.FromErr(err)
+			}
+			continue
+		}
+		j, err := json.Marshal(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(key, string(j)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// the cluster only returns the throttle period in milliseconds, keep the configured value if it's equivalent
+	throttlePeriod := watch.ThrottlePeriod
+	if watch.ThrottlePeriodInMillis > 0 {
+		throttlePeriod = fmt.Sprintf("%dms", watch.ThrottlePeriodInMillis)
+		if current, ok := d.GetOk("throttle_period"); ok {
+			if ms, err := parseTimeValue(current.(string)); err == nil && ms.Milliseconds() == watch.ThrottlePeriodInMillis {
+				throttlePeriod = current.(string)
+			}
+		}
+	}
+	if err := d.Set("throttle_period", throttlePeriod); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceWatchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeleteWatch(ctx, client, compId.ResourceId); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+// normalizeSearchInput removes the defaults the cluster adds to the request of a search input
+func normalizeSearchInput(input map[string]interface{}) {
+	search, ok := input["search"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	request, ok := search["request"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	if request["search_type"] == "query_then_fetch" {
+		delete(request, "search_type")
+	}
+	if request["rest_total_hits_as_int"] == true {
+		delete(request, "rest_total_hits_as_int")
+	}
+}
+
+var timeValueRegexp = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)(d|h|m|s|ms|micros|nanos)$`)
+
+var timeUnits = map[string]time.Duration{
+	"d":      24 * time.Hour,
+	"h":      time.Hour,
+	"m":      time.Minute,
+	"s":      time.Second,
+	"ms":     time.Millisecond,
+	"micros": time.Microsecond,
+	"nanos":  time.Nanosecond,
+}
+
+// parseTimeValue parses the Elasticsearch time unit notation, e.g. `1d`, `30s` or `1.5h`
+func parseTimeValue(v string) (time.Duration, error) {
+	matches := timeValueRegexp.FindStringSubmatch(v)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid time value: %s", v)
+	}
+	n, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(math.Round(n * float64(timeUnits[matches[2]]))), nil
+}
+
+func diffTimeValueSuppress(k, old, new string, d *schema.ResourceData) bool {
+	o, err := parseTimeValue(old)
+	if err != nil {
+		return false
+	}
+	n, err := parseTimeValue(new)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
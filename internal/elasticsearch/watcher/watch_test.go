@@ -0,0 +1,153 @@
+package watcher_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceWatch(t *testing.T) {
+	// generate a random name
+	watchID := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceWatchDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWatchCreate(watchID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "watch_id", watchID),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "active", "false"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "trigger", `{"schedule":{"cron":"0 0/1 * * * ?"}}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "input", `{"none":{}}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "condition", `{"always":{}}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "actions", `{}`),
+				),
+			},
+			{
+				Config: testAccResourceWatchUpdate(watchID, "10s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "watch_id", watchID),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "active", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "input", `{"search":{"request":{"body":{"query":{"match_all":{}}},"indices":["logstash*"]}}}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "condition", `{"compare":{"ctx.payload.hits.total":{"gt":0}}}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "actions", `{"log":{"logging":{"level":"info","text":"example logging text"}}}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "metadata", `{"example_key":"example_value"}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "throttle_period", "10s"),
+				),
+			},
+			{
+				Config: testAccResourceWatchUpdate(watchID, "1.5m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_watch.test", "throttle_period", "1.5m"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceWatchCreate(watchID string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_watch" "test" {
+  watch_id = "%s"
+  active   = false
+
+  trigger = jsonencode({
+    schedule = {
+      cron = "0 0/1 * * * ?"
+    }
+  })
+}
+	`, watchID)
+}
+
+func testAccResourceWatchUpdate(watchID, throttlePeriod string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_watch" "test" {
+  watch_id = "%s"
+  active   = true
+
+  trigger = jsonencode({
+    schedule = {
+      cron = "0 0/1 * * * ?"
+    }
+  })
+
+  input = jsonencode({
+    search = {
+      request = {
+        indices = ["logstash*"]
+        body = {
+          query = {
+            match_all = {}
+          }
+        }
+      }
+    }
+  })
+
+  condition = jsonencode({
+    compare = {
+      "ctx.payload.hits.total" = {
+        gt = 0
+      }
+    }
+  })
+
+  actions = jsonencode({
+    log = {
+      logging = {
+        level = "info"
+        text  = "example logging text"
+      }
+    }
+  })
+
+  metadata = jsonencode({
+    example_key = "example_value"
+  })
+
+  throttle_period = "%s"
+}
+	`, watchID, throttlePeriod)
+}
+
+func checkResourceWatchDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_watch" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+
+		watch, diags := elasticsearch.GetWatch(context.Background(), client, compId.ResourceId)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get watch %v", diags)
+		}
+		if watch != nil {
+			return fmt.Errorf("Watch (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
@@ -45,13 +45,73 @@ type UserPassword struct {
 }
 
 type Role struct {
-	Name         string                 `json:"-"`
-	Applications []Application          `json:"applications,omitempty"`
-	Global       map[string]interface{} `json:"global,omitempty"`
-	Cluster      []string               `json:"cluster,omitempty"`
-	Indices      []IndexPerms           `json:"indices,omitempty"`
-	Metadata     map[string]interface{} `json:"metadata,omitempty"`
-	RusAs        []string               `json:"run_as,omitempty"`
+	Name          string                 `json:"-"`
+	Description   string                 `json:"description,omitempty"`
+	Applications  []Application          `json:"applications,omitempty"`
+	Global        map[string]interface{} `json:"global,omitempty"`
+	Cluster       []string               `json:"cluster,omitempty"`
+	Indices       []IndexPerms           `json:"indices,omitempty"`
+	RemoteIndices []RemoteIndexPerms     `json:"remote_indices,omitempty"`
+	RemoteCluster []RemoteClusterPerms   `json:"remote_cluster,omitempty"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+	RusAs         []string               `json:"run_as,omitempty"`
+}
+
+type ApplicationPrivilege struct {
+	Application string                 `json:"-"`
+	Name        string                 `json:"-"`
+	Actions     []string               `json:"actions"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type ServiceToken struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type ServiceAccount struct {
+	RoleDescriptor map[string]interface{} `json:"role_descriptor"`
+}
+
+type ServiceCredentials struct {
+	ServiceAccount   string                 `json:"service_account"`
+	Count            int                    `json:"count"`
+	Tokens           map[string]interface{} `json:"tokens"`
+	NodesCredentials struct {
+		FileTokens map[string]interface{} `json:"file_tokens"`
+	} `json:"nodes_credentials"`
+}
+
+type BuiltinPrivileges struct {
+	Cluster       []string `json:"cluster"`
+	Index         []string `json:"index"`
+	RemoteCluster []string `json:"remote_cluster,omitempty"`
+}
+
+type HasPrivileges struct {
+	Cluster     []string                   `json:"cluster,omitempty"`
+	Index       []HasIndexPrivileges       `json:"index,omitempty"`
+	Application []HasApplicationPrivileges `json:"application,omitempty"`
+}
+
+type HasIndexPrivileges struct {
+	Names                  []string `json:"names"`
+	Privileges             []string `json:"privileges"`
+	AllowRestrictedIndices *bool    `json:"allow_restricted_indices,omitempty"`
+}
+
+type HasApplicationPrivileges struct {
+	Application string   `json:"application"`
+	Privileges  []string `json:"privileges"`
+	Resources   []string `json:"resources"`
+}
+
+type HasPrivilegesResponse struct {
+	Username        string                                `json:"username"`
+	HasAllRequested bool                                  `json:"has_all_requested"`
+	Cluster         map[string]bool                       `json:"cluster"`
+	Index           map[string]map[string]bool            `json:"index"`
+	Application     map[string]map[string]map[string]bool `json:"application"`
 }
 
 type RoleMapping struct {
@@ -63,6 +123,14 @@ type RoleMapping struct {
 	Metadata      interface{}              `json:"metadata"`
 }
 
+type RoleMappingUser struct {
+	Username string
+	Dn       string
+	Groups   []string
+	Realm    string
+	Metadata map[string]interface{}
+}
+
 type ApiKey struct {
 	Name             string                 `json:"name"`
 	RolesDescriptors map[string]Role        `json:"role_descriptors,omitempty"`
@@ -72,12 +140,59 @@ type ApiKey struct {
 
 type ApiKeyResponse struct {
 	ApiKey
-	RolesDescriptors map[string]Role `json:"role_descriptors,omitempty"`
-	Expiration       int64           `json:"expiration,omitempty"`
-	Id               string          `json:"id,omitempty"`
-	Key              string          `json:"api_key,omitempty"`
-	EncodedKey       string          `json:"encoded,omitempty"`
-	Invalidated      bool            `json:"invalidated,omitempty"`
+	RolesDescriptors map[string]Role           `json:"role_descriptors,omitempty"`
+	Expiration       int64                     `json:"expiration,omitempty"`
+	Creation         int64                     `json:"creation,omitempty"`
+	Id               string                    `json:"id,omitempty"`
+	Key              string                    `json:"api_key,omitempty"`
+	EncodedKey       string                    `json:"encoded,omitempty"`
+	Invalidated      bool                      `json:"invalidated,omitempty"`
+	Type             string                    `json:"type,omitempty"`
+	Access           *CrossClusterApiKeyAccess `json:"access,omitempty"`
+	Username         string                    `json:"username,omitempty"`
+	Realm            string                    `json:"realm,omitempty"`
+}
+
+type QueryApiKeysParams struct {
+	Name               string
+	Owner              string
+	Realm              string
+	Metadata           map[string]string
+	ExpiresBefore      *time.Time
+	IncludeInvalidated bool
+	Size               int
+}
+
+type ApiKeyGrant struct {
+	GrantType   string `json:"grant_type"`
+	Username    string `json:"username,omitempty"`
+	Password    string `json:"password,omitempty"`
+	AccessToken string `json:"access_token,omitempty"`
+	RunAs       string `json:"run_as,omitempty"`
+	ApiKey      ApiKey `json:"api_key"`
+}
+
+type CrossClusterApiKey struct {
+	Name       string                   `json:"name,omitempty"`
+	Expiration string                   `json:"expiration,omitempty"`
+	Access     CrossClusterApiKeyAccess `json:"access"`
+	Metadata   map[string]interface{}   `json:"metadata,omitempty"`
+}
+
+type CrossClusterApiKeyAccess struct {
+	Search      []CrossClusterSearchAccess      `json:"search,omitempty"`
+	Replication []CrossClusterReplicationAccess `json:"replication,omitempty"`
+}
+
+type CrossClusterSearchAccess struct {
+	Names                  []string       `json:"names"`
+	FieldSecurity          *FieldSecurity `json:"field_security,omitempty"`
+	Query                  *string        `json:"query,omitempty"`
+	AllowRestrictedIndices *bool          `json:"allow_restricted_indices,omitempty"`
+}
+
+type CrossClusterReplicationAccess struct {
+	Names []string `json:"names"`
 }
 
 type IndexPerms struct {
@@ -88,6 +203,16 @@ type IndexPerms struct {
 	AllowRestrictedIndices *bool          `json:"allow_restricted_indices,omitempty"`
 }
 
+type RemoteIndexPerms struct {
+	IndexPerms
+	Clusters []string `json:"clusters"`
+}
+
+type RemoteClusterPerms struct {
+	Clusters   []string `json:"clusters"`
+	Privileges []string `json:"privileges"`
+}
+
 type FieldSecurity struct {
 	Grant  []string `json:"grant,omitempty"`
 	Except []string `json:"except,omitempty"`
@@ -199,10 +324,11 @@ type SnapshotPolicyConfig struct {
 }
 
 type Index struct {
-	Name     string                 `json:"-"`
-	Aliases  map[string]IndexAlias  `json:"aliases,omitempty"`
-	Mappings map[string]interface{} `json:"mappings,omitempty"`
-	Settings map[string]interface{} `json:"settings,omitempty"`
+	Name       string                 `json:"-"`
+	Aliases    map[string]IndexAlias  `json:"aliases,omitempty"`
+	Mappings   map[string]interface{} `json:"mappings,omitempty"`
+	Settings   map[string]interface{} `json:"settings,omitempty"`
+	DataStream string                 `json:"data_stream,omitempty"`
 }
 
 type PutIndexParams struct {
@@ -262,3 +388,512 @@ type Script struct {
 	Params   map[string]interface{} `json:"params"`
 	Context  string                 `json:"-"`
 }
+
+type PainlessExecute struct {
+	Script       PainlessScript        `json:"script"`
+	Context      string                `json:"context,omitempty"`
+	ContextSetup *PainlessContextSetup `json:"context_setup,omitempty"`
+}
+
+type PainlessScript struct {
+	Source string                 `json:"source"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+type PainlessContextSetup struct {
+	Index    string                 `json:"index,omitempty"`
+	Document map[string]interface{} `json:"document,omitempty"`
+	Query    map[string]interface{} `json:"query,omitempty"`
+}
+
+type PainlessExecuteResult struct {
+	Result interface{} `json:"result"`
+}
+
+type ScriptError struct {
+	Type        string   `json:"type"`
+	Reason      string   `json:"reason"`
+	ScriptStack []string `json:"script_stack"`
+	Position    *struct {
+		Offset int `json:"offset"`
+		Start  int `json:"start"`
+		End    int `json:"end"`
+	} `json:"position"`
+	CausedBy *struct {
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	} `json:"caused_by"`
+}
+
+type Transform struct {
+	Id              string                    `json:"-"`
+	Description     string                    `json:"description,omitempty"`
+	Source          *TransformSource          `json:"source,omitempty"`
+	Destination     *TransformDestination     `json:"dest,omitempty"`
+	Pivot           map[string]interface{}    `json:"pivot,omitempty"`
+	Latest          map[string]interface{}    `json:"latest,omitempty"`
+	Frequency       string                    `json:"frequency,omitempty"`
+	Sync            *TransformSync            `json:"sync,omitempty"`
+	RetentionPolicy *TransformRetentionPolicy `json:"retention_policy,omitempty"`
+	Settings        map[string]interface{}    `json:"settings,omitempty"`
+	Meta            map[string]interface{}    `json:"_meta,omitempty"`
+}
+
+type TransformSource struct {
+	Indices         []string               `json:"index"`
+	Query           map[string]interface{} `json:"query,omitempty"`
+	RuntimeMappings map[string]interface{} `json:"runtime_mappings,omitempty"`
+}
+
+type TransformDestination struct {
+	Index    string `json:"index"`
+	Pipeline string `json:"pipeline,omitempty"`
+}
+
+type TransformSync struct {
+	Time *TransformTimeSync `json:"time,omitempty"`
+}
+
+type TransformTimeSync struct {
+	Field string `json:"field"`
+	Delay string `json:"delay,omitempty"`
+}
+
+type TransformRetentionPolicy struct {
+	Time *TransformTimeRetentionPolicy `json:"time,omitempty"`
+}
+
+type TransformTimeRetentionPolicy struct {
+	Field  string `json:"field"`
+	MaxAge string `json:"max_age"`
+}
+
+type TransformStats struct {
+	Id     string `json:"id"`
+	State  string `json:"state"`
+	Reason string `json:"reason,omitempty"`
+	Health *struct {
+		Status string `json:"status"`
+	} `json:"health,omitempty"`
+}
+
+type Watch struct {
+	WatchID                string                 `json:"-"`
+	Active                 bool                   `json:"-"`
+	Trigger                map[string]interface{} `json:"trigger"`
+	Input                  map[string]interface{} `json:"input,omitempty"`
+	Condition              map[string]interface{} `json:"condition,omitempty"`
+	Actions                map[string]interface{} `json:"actions,omitempty"`
+	Transform              map[string]interface{} `json:"transform,omitempty"`
+	Metadata               map[string]interface{} `json:"metadata,omitempty"`
+	ThrottlePeriod         string                 `json:"throttle_period,omitempty"`
+	ThrottlePeriodInMillis int64                  `json:"throttle_period_in_millis,omitempty"`
+}
+
+type WatchResponse struct {
+	WatchID string `json:"_id"`
+	Found   bool   `json:"found"`
+	Status  struct {
+		State struct {
+			Active bool `json:"active"`
+		} `json:"state"`
+	} `json:"status"`
+	Watch Watch `json:"watch"`
+}
+
+type AnomalyDetectionJob struct {
+	JobID                                string                     `json:"-"`
+	Description                          string                     `json:"description,omitempty"`
+	Groups                               []string                   `json:"groups,omitempty"`
+	AnalysisConfig                       *AnomalyDetectionAnalysis  `json:"analysis_config,omitempty"`
+	AnalysisLimits                       *AnomalyDetectionLimits    `json:"analysis_limits,omitempty"`
+	DataDescription                      *AnomalyDetectionData      `json:"data_description,omitempty"`
+	ModelPlotConfig                      *AnomalyDetectionModelPlot `json:"model_plot_config,omitempty"`
+	AllowLazyOpen                        *bool                      `json:"allow_lazy_open,omitempty"`
+	BackgroundPersistInterval            string                     `json:"background_persist_interval,omitempty"`
+	CustomSettings                       map[string]interface{}     `json:"custom_settings,omitempty"`
+	DailyModelSnapshotRetentionAfterDays *int                       `json:"daily_model_snapshot_retention_after_days,omitempty"`
+	ModelSnapshotRetentionDays           *int                       `json:"model_snapshot_retention_days,omitempty"`
+	RenormalizationWindowDays            *int                       `json:"renormalization_window_days,omitempty"`
+	ResultsRetentionDays                 *int                       `json:"results_retention_days,omitempty"`
+}
+
+// AnomalyDetectionJobUpdate is the body of the update job API, the description, groups and custom settings are always sent to reset the ones removed
+type AnomalyDetectionJobUpdate struct {
+	Description                          string                     `json:"description"`
+	Groups                               []string                   `json:"groups"`
+	AnalysisLimits                       *AnomalyDetectionLimits    `json:"analysis_limits,omitempty"`
+	ModelPlotConfig                      *AnomalyDetectionModelPlot `json:"model_plot_config,omitempty"`
+	AllowLazyOpen                        *bool                      `json:"allow_lazy_open,omitempty"`
+	BackgroundPersistInterval            string                     `json:"background_persist_interval,omitempty"`
+	CustomSettings                       map[string]interface{}     `json:"custom_settings"`
+	DailyModelSnapshotRetentionAfterDays *int                       `json:"daily_model_snapshot_retention_after_days,omitempty"`
+	ModelSnapshotRetentionDays           *int                       `json:"model_snapshot_retention_days,omitempty"`
+	RenormalizationWindowDays            *int                       `json:"renormalization_window_days,omitempty"`
+	ResultsRetentionDays                 *int                       `json:"results_retention_days,omitempty"`
+}
+
+type AnomalyDetectionAnalysis struct {
+	BucketSpan              string                     `json:"bucket_span"`
+	Detectors               []AnomalyDetectionDetector `json:"detectors"`
+	Influencers             []string                   `json:"influencers,omitempty"`
+	SummaryCountFieldName   string                     `json:"summary_count_field_name,omitempty"`
+	CategorizationFieldName string                     `json:"categorization_field_name,omitempty"`
+	Latency                 string                     `json:"latency,omitempty"`
+}
+
+type AnomalyDetectionDetector struct {
+	Function            string `json:"function"`
+	FieldName           string `json:"field_name,omitempty"`
+	ByFieldName         string `json:"by_field_name,omitempty"`
+	OverFieldName       string `json:"over_field_name,omitempty"`
+	PartitionFieldName  string `json:"partition_field_name,omitempty"`
+	DetectorDescription string `json:"detector_description,omitempty"`
+	ExcludeFrequent     string `json:"exclude_frequent,omitempty"`
+	UseNull             *bool  `json:"use_null,omitempty"`
+}
+
+type AnomalyDetectionLimits struct {
+	ModelMemoryLimit            string `json:"model_memory_limit,omitempty"`
+	CategorizationExamplesLimit *int   `json:"categorization_examples_limit,omitempty"`
+}
+
+type AnomalyDetectionData struct {
+	TimeField  string `json:"time_field,omitempty"`
+	TimeFormat string `json:"time_format,omitempty"`
+}
+
+type AnomalyDetectionModelPlot struct {
+	Enabled            bool   `json:"enabled"`
+	AnnotationsEnabled *bool  `json:"annotations_enabled,omitempty"`
+	Terms              string `json:"terms,omitempty"`
+}
+
+type AnomalyDetectionJobStats struct {
+	JobID string `json:"job_id"`
+	State string `json:"state"`
+}
+
+type Datafeed struct {
+	DatafeedID             string                    `json:"-"`
+	JobID                  string                    `json:"job_id,omitempty"`
+	Indices                []string                  `json:"indices,omitempty"`
+	Query                  map[string]interface{}    `json:"query,omitempty"`
+	Aggregations           map[string]interface{}    `json:"aggregations,omitempty"`
+	ScriptFields           map[string]interface{}    `json:"script_fields,omitempty"`
+	RuntimeMappings        map[string]interface{}    `json:"runtime_mappings,omitempty"`
+	Frequency              string                    `json:"frequency,omitempty"`
+	QueryDelay             string                    `json:"query_delay,omitempty"`
+	ScrollSize             *int                      `json:"scroll_size,omitempty"`
+	MaxEmptySearches       *int                      `json:"max_empty_searches,omitempty"`
+	ChunkingConfig         *DatafeedChunking         `json:"chunking_config,omitempty"`
+	DelayedDataCheckConfig *DatafeedDelayedDataCheck `json:"delayed_data_check_config,omitempty"`
+}
+
+// DatafeedUpdate is the body of the update datafeed API, the script fields and runtime mappings are always sent to reset the ones removed
+type DatafeedUpdate struct {
+	Indices                []string                  `json:"indices,omitempty"`
+	Query                  map[string]interface{}    `json:"query,omitempty"`
+	Aggregations           map[string]interface{}    `json:"aggregations,omitempty"`
+	ScriptFields           map[string]interface{}    `json:"script_fields"`
+	RuntimeMappings        map[string]interface{}    `json:"runtime_mappings"`
+	Frequency              string                    `json:"frequency,omitempty"`
+	QueryDelay             string                    `json:"query_delay,omitempty"`
+	ScrollSize             *int                      `json:"scroll_size,omitempty"`
+	MaxEmptySearches       *int                      `json:"max_empty_searches,omitempty"`
+	ChunkingConfig         *DatafeedChunking         `json:"chunking_config,omitempty"`
+	DelayedDataCheckConfig *DatafeedDelayedDataCheck `json:"delayed_data_check_config,omitempty"`
+}
+
+type DatafeedChunking struct {
+	Mode     string `json:"mode"`
+	TimeSpan string `json:"time_span,omitempty"`
+}
+
+type DatafeedDelayedDataCheck struct {
+	Enabled     bool   `json:"enabled"`
+	CheckWindow string `json:"check_window,omitempty"`
+}
+
+type DatafeedStats struct {
+	DatafeedID string `json:"datafeed_id"`
+	State      string `json:"state"`
+}
+
+type RemoteClusterInfo struct {
+	Connected                bool     `json:"connected"`
+	Mode                     string   `json:"mode"`
+	Seeds                    []string `json:"seeds"`
+	NumNodesConnected        int      `json:"num_nodes_connected"`
+	ProxyAddress             string   `json:"proxy_address"`
+	NumProxySocketsConnected int      `json:"num_proxy_sockets_connected"`
+	SkipUnavailable          bool     `json:"skip_unavailable"`
+}
+
+type FollowParameters struct {
+	MaxReadRequestOperationCount  *int   `json:"max_read_request_operation_count,omitempty"`
+	MaxOutstandingReadRequests    *int   `json:"max_outstanding_read_requests,omitempty"`
+	MaxReadRequestSize            string `json:"max_read_request_size,omitempty"`
+	MaxWriteRequestOperationCount *int   `json:"max_write_request_operation_count,omitempty"`
+	MaxWriteRequestSize           string `json:"max_write_request_size,omitempty"`
+	MaxOutstandingWriteRequests   *int   `json:"max_outstanding_write_requests,omitempty"`
+	MaxWriteBufferCount           *int   `json:"max_write_buffer_count,omitempty"`
+	MaxWriteBufferSize            string `json:"max_write_buffer_size,omitempty"`
+	MaxRetryDelay                 string `json:"max_retry_delay,omitempty"`
+	ReadPollTimeout               string `json:"read_poll_timeout,omitempty"`
+}
+
+type FollowerIndex struct {
+	Name          string                 `json:"-"`
+	RemoteCluster string                 `json:"remote_cluster"`
+	LeaderIndex   string                 `json:"leader_index"`
+	Settings      map[string]interface{} `json:"settings,omitempty"`
+	FollowParameters
+}
+
+type FollowerIndexInfo struct {
+	FollowerIndex string            `json:"follower_index"`
+	RemoteCluster string            `json:"remote_cluster"`
+	LeaderIndex   string            `json:"leader_index"`
+	Status        string            `json:"status"`
+	Parameters    *FollowParameters `json:"parameters,omitempty"`
+}
+
+type AutoFollowPattern struct {
+	Name                         string                 `json:"-"`
+	Active                       bool                   `json:"-"`
+	RemoteCluster                string                 `json:"remote_cluster"`
+	LeaderIndexPatterns          []string               `json:"leader_index_patterns"`
+	LeaderIndexExclusionPatterns []string               `json:"leader_index_exclusion_patterns,omitempty"`
+	FollowIndexPattern           string                 `json:"follow_index_pattern,omitempty"`
+	Settings                     map[string]interface{} `json:"settings,omitempty"`
+	FollowParameters
+}
+
+type CcrStats struct {
+	AutoFollowStats struct {
+		NumberOfFailedFollowIndices              int `json:"number_of_failed_follow_indices"`
+		NumberOfFailedRemoteClusterStateRequests int `json:"number_of_failed_remote_cluster_state_requests"`
+		NumberOfSuccessfulFollowIndices          int `json:"number_of_successful_follow_indices"`
+	} `json:"auto_follow_stats"`
+	FollowStats struct {
+		Indices []struct {
+			Index  string          `json:"index"`
+			Shards []CcrShardStats `json:"shards"`
+		} `json:"indices"`
+	} `json:"follow_stats"`
+}
+
+type CcrShardStats struct {
+	RemoteCluster            string `json:"remote_cluster"`
+	LeaderIndex              string `json:"leader_index"`
+	FollowerIndex            string `json:"follower_index"`
+	ShardID                  int    `json:"shard_id"`
+	LeaderGlobalCheckpoint   int64  `json:"leader_global_checkpoint"`
+	FollowerGlobalCheckpoint int64  `json:"follower_global_checkpoint"`
+	OperationsRead           int64  `json:"operations_read"`
+	OperationsWritten        int64  `json:"operations_written"`
+	FailedReadRequests       int64  `json:"failed_read_requests"`
+	FailedWriteRequests      int64  `json:"failed_write_requests"`
+	TimeSinceLastReadMillis  int64  `json:"time_since_last_read_millis"`
+}
+
+type SnapshotInfo struct {
+	Snapshot           string                 `json:"snapshot"`
+	UUID               string                 `json:"uuid"`
+	Repository         string                 `json:"repository,omitempty"`
+	Version            string                 `json:"version"`
+	Indices            []string               `json:"indices"`
+	DataStreams        []string               `json:"data_streams"`
+	FeatureStates      []SnapshotFeatureState `json:"feature_states"`
+	IncludeGlobalState bool                   `json:"include_global_state"`
+	Metadata           map[string]interface{} `json:"metadata,omitempty"`
+	State              string                 `json:"state"`
+	StartTime          string                 `json:"start_time"`
+	StartTimeInMillis  int64                  `json:"start_time_in_millis"`
+	EndTime            string                 `json:"end_time"`
+	EndTimeInMillis    int64                  `json:"end_time_in_millis"`
+	DurationInMillis   int64                  `json:"duration_in_millis"`
+	Shards             SnapshotShards         `json:"shards"`
+}
+
+type SnapshotFeatureState struct {
+	FeatureName string   `json:"feature_name"`
+	Indices     []string `json:"indices"`
+}
+
+type SnapshotShards struct {
+	Total      int `json:"total"`
+	Failed     int `json:"failed"`
+	Successful int `json:"successful"`
+}
+
+type SnapshotStatus struct {
+	Snapshot    string `json:"snapshot"`
+	Repository  string `json:"repository"`
+	UUID        string `json:"uuid"`
+	State       string `json:"state"`
+	ShardsStats struct {
+		Initializing int `json:"initializing"`
+		Started      int `json:"started"`
+		Finalizing   int `json:"finalizing"`
+		Done         int `json:"done"`
+		Failed       int `json:"failed"`
+		Total        int `json:"total"`
+	} `json:"shards_stats"`
+}
+
+type SnapshotRestore struct {
+	Indices             []string               `json:"indices,omitempty"`
+	IgnoreUnavailable   *bool                  `json:"ignore_unavailable,omitempty"`
+	IncludeGlobalState  *bool                  `json:"include_global_state,omitempty"`
+	FeatureStates       []string               `json:"feature_states,omitempty"`
+	IncludeAliases      *bool                  `json:"include_aliases,omitempty"`
+	Partial             *bool                  `json:"partial,omitempty"`
+	RenamePattern       string                 `json:"rename_pattern,omitempty"`
+	RenameReplacement   string                 `json:"rename_replacement,omitempty"`
+	IndexSettings       map[string]interface{} `json:"index_settings,omitempty"`
+	IgnoreIndexSettings []string               `json:"ignore_index_settings,omitempty"`
+}
+
+type SnapshotRestoreInfo struct {
+	Snapshot string         `json:"snapshot"`
+	Indices  []string       `json:"indices"`
+	Shards   SnapshotShards `json:"shards"`
+}
+
+type GetSnapshotsParams struct {
+	Sort  string
+	Order string
+	Size  int
+}
+
+type SnapshotPolicyInfo struct {
+	Version             int                       `json:"version"`
+	ModifiedDateMillis  int64                     `json:"modified_date_millis"`
+	Policy              SnapshotPolicy            `json:"policy"`
+	LastSuccess         *SnapshotPolicyInvocation `json:"last_success,omitempty"`
+	LastFailure         *SnapshotPolicyInvocation `json:"last_failure,omitempty"`
+	NextExecution       string                    `json:"next_execution"`
+	NextExecutionMillis int64                     `json:"next_execution_millis"`
+	Stats               SnapshotPolicyStats       `json:"stats"`
+}
+
+type SnapshotPolicyInvocation struct {
+	SnapshotName string `json:"snapshot_name"`
+	TimeMillis   int64  `json:"time"`
+	Details      string `json:"details,omitempty"`
+}
+
+type SnapshotPolicyStats struct {
+	SnapshotsTaken           int `json:"snapshots_taken"`
+	SnapshotsFailed          int `json:"snapshots_failed"`
+	SnapshotsDeleted         int `json:"snapshots_deleted"`
+	SnapshotDeletionFailures int `json:"snapshot_deletion_failures"`
+}
+
+type SnapshotRepositoryCleanup struct {
+	DeletedBytes int64 `json:"deleted_bytes"`
+	DeletedBlobs int64 `json:"deleted_blobs"`
+}
+
+type SnapshotRepositoryAnalyzeParams struct {
+	BlobCount          int
+	Concurrency        int
+	ReadNodeCount      int
+	EarlyReadNodeCount int
+	Seed               *int
+	MaxBlobSize        string
+	MaxTotalDataSize   string
+	Timeout            time.Duration
+}
+
+type SnapshotRepositoryAnalysis struct {
+	CoordinatingNode struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"coordinating_node"`
+	BlobCount          int      `json:"blob_count"`
+	Concurrency        int      `json:"concurrency"`
+	ReadNodeCount      int      `json:"read_node_count"`
+	EarlyReadNodeCount int      `json:"early_read_node_count"`
+	MaxBlobSize        string   `json:"max_blob_size"`
+	MaxTotalDataSize   string   `json:"max_total_data_size"`
+	Seed               int64    `json:"seed"`
+	BlobPath           string   `json:"blob_path"`
+	IssuesDetected     []string `json:"issues_detected"`
+	Summary            struct {
+		Write struct {
+			Count               int64 `json:"count"`
+			TotalSizeBytes      int64 `json:"total_size_bytes"`
+			TotalThrottledNanos int64 `json:"total_throttled_nanos"`
+			TotalElapsedNanos   int64 `json:"total_elapsed_nanos"`
+		} `json:"write"`
+		Read struct {
+			Count               int64 `json:"count"`
+			TotalWaitNanos      int64 `json:"total_wait_nanos"`
+			MaxWaitNanos        int64 `json:"max_wait_nanos"`
+			TotalSizeBytes      int64 `json:"total_size_bytes"`
+			TotalThrottledNanos int64 `json:"total_throttled_nanos"`
+			TotalElapsedNanos   int64 `json:"total_elapsed_nanos"`
+		} `json:"read"`
+	} `json:"summary"`
+	ListingElapsedNanos int64 `json:"listing_elapsed_nanos"`
+	DeleteElapsedNanos  int64 `json:"delete_elapsed_nanos"`
+}
+
+type ClusterHealthParams struct {
+	Indices                     []string
+	WaitForStatus               string
+	WaitForNodes                string
+	WaitForActiveShards         string
+	WaitForNoRelocatingShards   bool
+	WaitForNoInitializingShards bool
+	Timeout                     time.Duration
+}
+
+type ClusterHealth struct {
+	ClusterName                 string  `json:"cluster_name"`
+	Status                      string  `json:"status"`
+	TimedOut                    bool    `json:"timed_out"`
+	NumberOfNodes               int     `json:"number_of_nodes"`
+	NumberOfDataNodes           int     `json:"number_of_data_nodes"`
+	ActivePrimaryShards         int     `json:"active_primary_shards"`
+	ActiveShards                int     `json:"active_shards"`
+	RelocatingShards            int     `json:"relocating_shards"`
+	InitializingShards          int     `json:"initializing_shards"`
+	UnassignedShards            int     `json:"unassigned_shards"`
+	DelayedUnassignedShards     int     `json:"delayed_unassigned_shards"`
+	NumberOfPendingTasks        int     `json:"number_of_pending_tasks"`
+	NumberOfInFlightFetch       int     `json:"number_of_in_flight_fetch"`
+	TaskMaxWaitingInQueueMillis int64   `json:"task_max_waiting_in_queue_millis"`
+	ActiveShardsPercentAsNumber float64 `json:"active_shards_percent_as_number"`
+}
+
+type NodesInfo struct {
+	ClusterName string              `json:"cluster_name"`
+	Nodes       map[string]NodeInfo `json:"nodes"`
+}
+
+type NodeInfo struct {
+	Name             string            `json:"name"`
+	TransportAddress string            `json:"transport_address"`
+	Host             string            `json:"host"`
+	Ip               string            `json:"ip"`
+	Version          string            `json:"version"`
+	BuildFlavor      string            `json:"build_flavor"`
+	BuildType        string            `json:"build_type"`
+	BuildHash        string            `json:"build_hash"`
+	Roles            []string          `json:"roles"`
+	Attributes       map[string]string `json:"attributes"`
+	Plugins          []NodePlugin      `json:"plugins"`
+	Modules          []NodePlugin      `json:"modules"`
+}
+
+type NodePlugin struct {
+	Name                 string `json:"name"`
+	Version              string `json:"version"`
+	ElasticsearchVersion string `json:"elasticsearch_version"`
+	Description          string `json:"description"`
+	Classname            string `json:"classname"`
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha1"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"reflect"
@@ -35,6 +36,19 @@ func CheckError(res *esapi.Response, errMsg string) diag.Diagnostics {
 	return diags
 }
 
+// DiagsAsError returns the first error of the diagnostics, for the functions which can only return an error
+func DiagsAsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			if d.Detail == "" {
+				return errors.New(d.Summary)
+			}
+			return fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+	return nil
+}
+
 // Compares the JSON in two byte slices
 func JSONBytesEqual(a, b []byte) (bool, error) {
 	var j, j2 interface{}
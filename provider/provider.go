@@ -2,11 +2,15 @@ package provider
 
 import (
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ccr"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/cluster"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ingest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/logstash"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ml"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/security"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/transform"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/watcher"
 	providerSchema "github.com/elastic/terraform-provider-elasticstack/internal/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -26,6 +30,9 @@ func New(version string) *schema.Provider {
 			esKeyName: providerSchema.GetConnectionSchema(esKeyName, true),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
+			"elasticstack_elasticsearch_ccr_stats":                          ccr.DataSourceStats(),
+			"elasticstack_elasticsearch_cluster_health":                     cluster.DataSourceClusterHealth(),
+			"elasticstack_elasticsearch_info":                               cluster.DataSourceInfo(),
 			"elasticstack_elasticsearch_ingest_processor_append":            ingest.DataSourceProcessorAppend(),
 			"elasticstack_elasticsearch_ingest_processor_bytes":             ingest.DataSourceProcessorBytes(),
 			"elasticstack_elasticsearch_ingest_processor_circle":            ingest.DataSourceProcessorCircle(),
@@ -64,28 +71,53 @@ func New(version string) *schema.Provider {
 			"elasticstack_elasticsearch_ingest_processor_urldecode":         ingest.DataSourceProcessorUrldecode(),
 			"elasticstack_elasticsearch_ingest_processor_uri_parts":         ingest.DataSourceProcessorUriParts(),
 			"elasticstack_elasticsearch_ingest_processor_user_agent":        ingest.DataSourceProcessorUserAgent(),
+			"elasticstack_elasticsearch_nodes":                              cluster.DataSourceNodes(),
+			"elasticstack_elasticsearch_painless_execute":                   cluster.DataSourcePainlessExecute(),
+			"elasticstack_elasticsearch_security_api_keys":                  security.DataSourceApiKeys(),
+			"elasticstack_elasticsearch_security_application_privilege":     security.DataSourceApplicationPrivilege(),
+			"elasticstack_elasticsearch_security_builtin_privileges":        security.DataSourceBuiltinPrivileges(),
+			"elasticstack_elasticsearch_security_has_privileges":            security.DataSourceHasPrivileges(),
 			"elasticstack_elasticsearch_security_role":                      security.DataSourceRole(),
 			"elasticstack_elasticsearch_security_role_mapping":              security.DataSourceRoleMapping(),
+			"elasticstack_elasticsearch_security_role_mapping_evaluation":   security.DataSourceRoleMappingEvaluation(),
+			"elasticstack_elasticsearch_security_service_accounts":          security.DataSourceServiceAccounts(),
 			"elasticstack_elasticsearch_security_user":                      security.DataSourceUser(),
+			"elasticstack_elasticsearch_snapshot_lifecycle":                 cluster.DataSourceSlm(),
 			"elasticstack_elasticsearch_snapshot_repository":                cluster.DataSourceSnapshotRespository(),
+			"elasticstack_elasticsearch_snapshot_repository_analysis":       cluster.DataSourceSnapshotRepositoryAnalysis(),
+			"elasticstack_elasticsearch_snapshot_repository_verification":   cluster.DataSourceSnapshotRepositoryVerification(),
+			"elasticstack_elasticsearch_snapshots":                          cluster.DataSourceSnapshots(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"elasticstack_elasticsearch_cluster_settings":      cluster.ResourceSettings(),
-			"elasticstack_elasticsearch_component_template":    index.ResourceComponentTemplate(),
-			"elasticstack_elasticsearch_data_stream":           index.ResourceDataStream(),
-			"elasticstack_elasticsearch_index":                 index.ResourceIndex(),
-			"elasticstack_elasticsearch_index_lifecycle":       index.ResourceIlm(),
-			"elasticstack_elasticsearch_index_template":        index.ResourceTemplate(),
-			"elasticstack_elasticsearch_ingest_pipeline":       ingest.ResourceIngestPipeline(),
-			"elasticstack_elasticsearch_logstash_pipeline":     logstash.ResourceLogstashPipeline(),
-			"elasticstack_elasticsearch_security_api_key":      security.ResourceApiKey(),
-			"elasticstack_elasticsearch_security_role":         security.ResourceRole(),
-			"elasticstack_elasticsearch_security_role_mapping": security.ResourceRoleMapping(),
-			"elasticstack_elasticsearch_security_user":         security.ResourceUser(),
-			"elasticstack_elasticsearch_security_system_user":  security.ResourceSystemUser(),
-			"elasticstack_elasticsearch_snapshot_lifecycle":    cluster.ResourceSlm(),
-			"elasticstack_elasticsearch_snapshot_repository":   cluster.ResourceSnapshotRepository(),
-			"elasticstack_elasticsearch_script":                cluster.ResourceScript(),
+			"elasticstack_elasticsearch_ccr_auto_follow_pattern":        ccr.ResourceAutoFollowPattern(),
+			"elasticstack_elasticsearch_ccr_follower_index":             ccr.ResourceFollowerIndex(),
+			"elasticstack_elasticsearch_cluster_settings":               cluster.ResourceSettings(),
+			"elasticstack_elasticsearch_component_template":             index.ResourceComponentTemplate(),
+			"elasticstack_elasticsearch_data_stream":                    index.ResourceDataStream(),
+			"elasticstack_elasticsearch_index":                          index.ResourceIndex(),
+			"elasticstack_elasticsearch_index_lifecycle":                index.ResourceIlm(),
+			"elasticstack_elasticsearch_index_template":                 index.ResourceTemplate(),
+			"elasticstack_elasticsearch_ingest_pipeline":                ingest.ResourceIngestPipeline(),
+			"elasticstack_elasticsearch_logstash_pipeline":              logstash.ResourceLogstashPipeline(),
+			"elasticstack_elasticsearch_ml_anomaly_detection_job":       ml.ResourceAnomalyDetectionJob(),
+			"elasticstack_elasticsearch_ml_datafeed":                    ml.ResourceDatafeed(),
+			"elasticstack_elasticsearch_remote_cluster":                 cluster.ResourceRemoteCluster(),
+			"elasticstack_elasticsearch_security_api_key":               security.ResourceApiKey(),
+			"elasticstack_elasticsearch_security_application_privilege": security.ResourceApplicationPrivilege(),
+			"elasticstack_elasticsearch_security_cross_cluster_api_key": security.ResourceCrossClusterApiKey(),
+			"elasticstack_elasticsearch_security_role":                  security.ResourceRole(),
+			"elasticstack_elasticsearch_security_role_mapping":          security.ResourceRoleMapping(),
+			"elasticstack_elasticsearch_security_user":                  security.ResourceUser(),
+			"elasticstack_elasticsearch_security_service_token":         security.ResourceServiceToken(),
+			"elasticstack_elasticsearch_security_system_user":           security.ResourceSystemUser(),
+			"elasticstack_elasticsearch_snapshot":                       cluster.ResourceSnapshot(),
+			"elasticstack_elasticsearch_snapshot_lifecycle":             cluster.ResourceSlm(),
+			"elasticstack_elasticsearch_snapshot_lifecycle_settings":    cluster.ResourceSlmSettings(),
+			"elasticstack_elasticsearch_snapshot_repository":            cluster.ResourceSnapshotRepository(),
+			"elasticstack_elasticsearch_snapshot_restore":               cluster.ResourceSnapshotRestore(),
+			"elasticstack_elasticsearch_script":                         cluster.ResourceScript(),
+			"elasticstack_elasticsearch_transform":                      transform.ResourceTransform(),
+			"elasticstack_elasticsearch_watch":                          watcher.ResourceWatch(),
 		},
 	}
 